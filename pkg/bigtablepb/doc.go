@@ -0,0 +1,10 @@
+// Package bigtablepb holds the generated messages and gRPC service of the Cloud
+// Bigtable v2 data API (google.bigtable.v2.Bigtable).
+//
+// The .pb.go files are those of google.golang.org/genproto/googleapis/bigtable/v2
+// at v0.0.0-20230410155749-daa745c078e1, with only the package clause changed.
+// That genproto version still bundles googleapis/rpc, which grpc now requires
+// as a separate module, so importing it directly is an ambiguous import.
+// Later genproto versions only alias cloud.google.com/go/bigtable/apiv2/bigtablepb.
+// Replace this package with that one when the module can be required.
+package bigtablepb
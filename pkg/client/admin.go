@@ -0,0 +1,110 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/Gourab-18/google_big_table/pkg/master"
+	"github.com/Gourab-18/google_big_table/pkg/tablet"
+)
+
+// AdminClient manages the tables of a cluster through its master. It is safe
+// for concurrent use.
+type AdminClient struct {
+	// HTTPClient sends the requests. It defaults to http.DefaultClient.
+	HTTPClient *http.Client
+
+	masterAddr string
+}
+
+// NewAdminClient creates an admin client of the master at masterAddr
+// ("host:port" or a URL).
+func NewAdminClient(masterAddr string) *AdminClient {
+	return &AdminClient{HTTPClient: http.DefaultClient, masterAddr: masterAddr}
+}
+
+// CreateTable creates a table with the given column families, split at
+// splitKeys.
+func (a *AdminClient) CreateTable(ctx context.Context, name string, splitKeys []string, families []tablet.ColumnFamily) (*master.TableInfo, error) {
+	req := struct {
+		Name           string
+		SplitKeys      []string
+		ColumnFamilies []tablet.ColumnFamily
+	}{name, splitKeys, families}
+	var info master.TableInfo
+	if err := a.call(ctx, http.MethodPost, "/tables", req, &info); err != nil {
+		return nil, err
+	}
+	return &info, nil
+}
+
+// DeleteTable deletes a table.
+func (a *AdminClient) DeleteTable(ctx context.Context, name string) error {
+	return a.call(ctx, http.MethodDelete, "/table?name="+url.QueryEscape(name), nil, nil)
+}
+
+// ListTables returns every table, sorted by name.
+func (a *AdminClient) ListTables(ctx context.Context) ([]master.TableInfo, error) {
+	var tables []master.TableInfo
+	if err := a.call(ctx, http.MethodGet, "/tables", nil, &tables); err != nil {
+		return nil, err
+	}
+	return tables, nil
+}
+
+// GetTable returns a table. Unknown tables are reported as an *Error with
+// status 404.
+func (a *AdminClient) GetTable(ctx context.Context, name string) (*master.TableInfo, error) {
+	var info master.TableInfo
+	if err := a.call(ctx, http.MethodGet, "/table?name="+url.QueryEscape(name), nil, &info); err != nil {
+		return nil, err
+	}
+	return &info, nil
+}
+
+// ModifyColumnFamilies applies modifications to the column families of a
+// table, all or none, and returns the table.
+func (a *AdminClient) ModifyColumnFamilies(ctx context.Context, name string, mods []master.ColumnFamilyModification) (*master.TableInfo, error) {
+	var info master.TableInfo
+	if err := a.call(ctx, http.MethodPost, "/table/families?name="+url.QueryEscape(name), mods, &info); err != nil {
+		return nil, err
+	}
+	return &info, nil
+}
+
+// call sends a request to the master with in as the JSON body, if not nil, and
+// decodes the JSON response into out, if not nil. Error responses are
+// returned as *Error.
+func (a *AdminClient) call(ctx context.Context, method, path string, in, out any) error {
+	var body io.Reader
+	if in != nil {
+		data, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, baseURL(a.masterAddr)+path, body)
+	if err != nil {
+		return err
+	}
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := a.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return readError(resp)
+	}
+	if out == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
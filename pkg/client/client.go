@@ -1 +1,499 @@
+// Package client is a Go client for the tablet servers' HTTP API.
+//
+// The client bootstraps from the master's root location, reads the tablet map
+// from the METADATA table served there, caches the tablet locations, and sends
+// every request straight to the tablet server that owns the row. When a server
+// answers that it does not serve a key (the tablet split or moved), the stale
+// location is dropped, the map is fetched again, and the request is retried.
+//
+// A Client works on one table; Open returns a client of another one, and
+// AdminClient manages the tables.
 package client
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/Gourab-18/google_big_table/pkg/master"
+	"github.com/Gourab-18/google_big_table/pkg/tablet"
+)
+
+// maxAttempts is how many times a request is sent before a routing error is returned.
+const maxAttempts = 3
+
+// Client routes requests to tablet servers. It is safe for concurrent use.
+type Client struct {
+	// HTTPClient sends the requests. It defaults to http.DefaultClient.
+	HTTPClient *http.Client
+
+	masterAddr string
+	table      string
+
+	mu        sync.RWMutex
+	locations []master.TabletLocation // Sorted by StartKey
+	refreshMu sync.Mutex              // Serializes refreshes of the map
+}
+
+// NewClient creates a client of the default table of the cluster managed by
+// the master at masterAddr ("host:port" or a URL). The tablet map is fetched
+// on first use.
+func NewClient(masterAddr string) *Client {
+	return &Client{
+		HTTPClient: http.DefaultClient,
+		masterAddr: masterAddr,
+		table:      master.DefaultTable,
+	}
+}
+
+// Open returns a client of another table of the same cluster, with its own
+// tablet map.
+func (c *Client) Open(table string) *Client {
+	return &Client{
+		HTTPClient: c.HTTPClient,
+		masterAddr: c.masterAddr,
+		table:      table,
+	}
+}
+
+// Error is an error response of a server.
+type Error struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("server returned %d: %s", e.StatusCode, e.Message)
+}
+
+// statusNotServed is the status tablet servers answer requests for keys they
+// do not serve with (tabletserver.StatusNotServed).
+const statusNotServed = http.StatusMisdirectedRequest
+
+// isRoutingError reports whether a server refused a request because it does not
+// serve the key, which means our location for it is stale.
+func isRoutingError(err error) bool {
+	var e *Error
+	return errors.As(err, &e) && e.StatusCode == statusNotServed
+}
+
+// Apply atomically applies a mutation to its row.
+func (c *Client) Apply(ctx context.Context, m *tablet.RowMutation) error {
+	body := struct {
+		RowKey string
+		Ops    []tablet.MutationOperation
+	}{m.RowKey, m.Ops}
+	return c.withLocation(ctx, m.RowKey, func(loc master.TabletLocation) error {
+		return c.post(ctx, loc, "/mutate", body, nil)
+	})
+}
+
+// ReadRow reads the cells of a row selected by filter. It returns nil if the
+// row has no such cells.
+func (c *Client) ReadRow(ctx context.Context, rowKey string, filter tablet.ReadFilter) (*tablet.Row, error) {
+	q := url.Values{"key": {rowKey}}
+	q["family"] = filter.Families
+	q["column"] = filter.Columns
+	if err := addReadOptions(q, filter.Versions); err != nil {
+		return nil, err
+	}
+	if err := addRowFilter(q, filter.Filter); err != nil {
+		return nil, err
+	}
+
+	var row *tablet.Row
+	err := c.withLocation(ctx, rowKey, func(loc master.TabletLocation) error {
+		row = nil
+		err := c.get(ctx, loc, "/row", q, &row)
+		var e *Error
+		if errors.As(err, &e) && e.StatusCode == http.StatusNotFound {
+			return nil // The row has no cells
+		}
+		return err
+	})
+	return row, err
+}
+
+// ReadRows calls fn for every row with a key in [start, end), in key order,
+// until fn returns false. An empty end reads to the end of the table.
+// opts.Limit caps the number of rows and opts.Filter narrows them.
+//
+// The range is read one tablet at a time. If a tablet moves during the read,
+// the read resumes after the last row delivered.
+func (c *Client) ReadRows(ctx context.Context, start, end string, opts tablet.ScanOptions, fn func(*tablet.Row) bool) error {
+	q := url.Values{}
+	if err := addRowFilter(q, opts.Filter); err != nil {
+		return err
+	}
+
+	sent := 0
+	for attempt := 0; ; {
+		if end != "" && start >= end {
+			return nil
+		}
+		loc, err := c.locate(ctx, start)
+		if err != nil {
+			return err
+		}
+
+		// Read the part of the range this tablet holds.
+		tabletEnd := end
+		if loc.EndKey != "" && (end == "" || loc.EndKey < end) {
+			tabletEnd = loc.EndKey
+		}
+		q.Set("start", start)
+		q.Set("end", tabletEnd)
+		if opts.Limit > 0 {
+			q.Set("limit", strconv.Itoa(opts.Limit-sent))
+		}
+
+		n, lastKey, stopped, err := c.scan(ctx, loc, q, fn)
+		sent += n
+		if n > 0 {
+			attempt = 0
+			start = lastKey + "\x00"
+		}
+		switch {
+		case isRoutingError(err):
+			c.invalidate(loc)
+			if attempt++; attempt == maxAttempts {
+				return err
+			}
+			continue
+		case err != nil:
+			return err
+		case stopped || (opts.Limit > 0 && sent >= opts.Limit) || loc.EndKey == "":
+			return nil
+		}
+		start = loc.EndKey
+	}
+}
+
+// scan reads one /scan response, calling fn for every row. It returns the
+// number of rows delivered, the last key delivered, and whether fn stopped the read.
+func (c *Client) scan(ctx context.Context, loc master.TabletLocation, q url.Values, fn func(*tablet.Row) bool) (int, string, bool, error) {
+	resp, err := c.do(ctx, loc, http.MethodGet, "/scan?"+q.Encode(), nil)
+	if err != nil {
+		return 0, "", false, err
+	}
+	defer resp.Body.Close()
+
+	var line struct {
+		Row           *tablet.Row
+		NextPageToken string
+		Error         string
+	}
+	n, lastKey := 0, ""
+	dec := json.NewDecoder(bufio.NewReader(resp.Body))
+	for {
+		line.Row, line.Error = nil, ""
+		if err := dec.Decode(&line); err == io.EOF {
+			return n, lastKey, false, nil
+		} else if err != nil {
+			return n, lastKey, false, fmt.Errorf("failed to read scan: %w", err)
+		}
+		if line.Error != "" {
+			return n, lastKey, false, &Error{StatusCode: http.StatusInternalServerError, Message: line.Error}
+		}
+		if line.Row == nil {
+			continue // Page token: the limit was reached
+		}
+		n++
+		lastKey = line.Row.Key
+		if !fn(line.Row) {
+			return n, lastKey, true, nil
+		}
+	}
+}
+
+// CheckAndMutate atomically applies trueMutation to a row if predicate outputs
+// any of its cells, and falseMutation otherwise. It reports whether the
+// predicate matched. A nil predicate matches any row with cells.
+func (c *Client) CheckAndMutate(ctx context.Context, rowKey string, predicate *tablet.RowFilter, trueMutation, falseMutation *tablet.RowMutation) (bool, error) {
+	body := struct {
+		RowKey    string
+		Predicate *tablet.RowFilter
+		TrueOps   []tablet.MutationOperation
+		FalseOps  []tablet.MutationOperation
+	}{RowKey: rowKey, Predicate: predicate}
+	if trueMutation != nil {
+		body.TrueOps = trueMutation.Ops
+	}
+	if falseMutation != nil {
+		body.FalseOps = falseMutation.Ops
+	}
+
+	var resp struct{ Matched bool }
+	err := c.withLocation(ctx, rowKey, func(loc master.TabletLocation) error {
+		return c.post(ctx, loc, "/check-and-mutate", body, &resp)
+	})
+	return resp.Matched, err
+}
+
+// ReadModifyWrite atomically applies increments and appends to a row and
+// returns the new cells.
+func (c *Client) ReadModifyWrite(ctx context.Context, rmw *tablet.ReadModifyWriteRow) (*tablet.Row, error) {
+	var row *tablet.Row
+	err := c.withLocation(ctx, rmw.RowKey, func(loc master.TabletLocation) error {
+		return c.post(ctx, loc, "/read-modify-write", rmw, &row)
+	})
+	return row, err
+}
+
+// withLocation calls fn with the location of the tablet serving key, retrying
+// with a fresh location when the server reports that it does not serve the key.
+// Such requests were not applied, so retrying them is safe.
+func (c *Client) withLocation(ctx context.Context, key string, fn func(loc master.TabletLocation) error) error {
+	var err error
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		var loc master.TabletLocation
+		if loc, err = c.locate(ctx, key); err != nil {
+			return err
+		}
+		if err = fn(loc); !isRoutingError(err) {
+			return err
+		}
+		c.invalidate(loc)
+	}
+	return err
+}
+
+// locate returns the location of the tablet serving key, fetching the tablet
+// map from the master if it is not cached.
+func (c *Client) locate(ctx context.Context, key string) (master.TabletLocation, error) {
+	if loc, ok := c.cached(key); ok {
+		return loc, nil
+	}
+
+	// Only one caller refreshes; the others wait and use its result.
+	c.refreshMu.Lock()
+	defer c.refreshMu.Unlock()
+	if loc, ok := c.cached(key); ok {
+		return loc, nil
+	}
+	if err := c.refresh(ctx); err != nil {
+		return master.TabletLocation{}, err
+	}
+	if loc, ok := c.cached(key); ok {
+		return loc, nil
+	}
+	return master.TabletLocation{}, fmt.Errorf("no tablet location for key '%s'", key)
+}
+
+// cached looks key up in the cached tablet map. Tablets the master has not
+// assigned to a server yet count as missing.
+func (c *Client) cached(key string) (master.TabletLocation, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	// The last tablet starting at or before key.
+	i := sort.Search(len(c.locations), func(i int) bool { return c.locations[i].StartKey > key }) - 1
+	if i < 0 {
+		return master.TabletLocation{}, false
+	}
+	loc := c.locations[i]
+	if (loc.EndKey != "" && key >= loc.EndKey) || loc.ServerID == "" {
+		return master.TabletLocation{}, false
+	}
+	return loc, true
+}
+
+// refresh replaces the cached tablet map with the one in the METADATA table,
+// found through the master's root location. Masters without METADATA serve
+// the map at /tablets instead.
+func (c *Client) refresh(ctx context.Context) error {
+	var root master.TabletLocation
+	err := c.fetchMaster(ctx, "/root", &root)
+	var e *Error
+	if errors.As(err, &e) && e.StatusCode == http.StatusNotFound {
+		return c.refreshFrom(ctx, "/tablets?table="+url.QueryEscape(c.table))
+	}
+	if err != nil {
+		return fmt.Errorf("failed to fetch root location: %w", err)
+	}
+
+	start, end := master.MetadataTableRange(c.table)
+	locations := make([]master.TabletLocation, 0)
+	var decodeErr error
+	_, _, _, err = c.scan(ctx, root, url.Values{"start": {start}, "end": {end}}, func(row *tablet.Row) bool {
+		var loc master.TabletLocation
+		if loc, decodeErr = master.LocationFromRow(row); decodeErr != nil {
+			return false
+		}
+		locations = append(locations, loc)
+		return true
+	})
+	if err == nil {
+		err = decodeErr
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read METADATA: %w", err)
+	}
+	c.setLocations(locations)
+	return nil
+}
+
+// refreshFrom replaces the cached tablet map with the one the master serves at path.
+func (c *Client) refreshFrom(ctx context.Context, path string) error {
+	var locations []master.TabletLocation
+	if err := c.fetchMaster(ctx, path, &locations); err != nil {
+		return fmt.Errorf("failed to fetch tablet map: %w", err)
+	}
+	c.setLocations(locations)
+	return nil
+}
+
+// fetchMaster gets path from the master and decodes the JSON response into out.
+func (c *Client) fetchMaster(ctx context.Context, path string, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, baseURL(c.masterAddr)+path, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return readError(resp)
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// setLocations replaces the cached tablet map.
+func (c *Client) setLocations(locations []master.TabletLocation) {
+	sort.Slice(locations, func(i, j int) bool { return locations[i].StartKey < locations[j].StartKey })
+
+	c.mu.Lock()
+	c.locations = locations
+	c.mu.Unlock()
+}
+
+// invalidate drops a stale location from the cache, so the next lookup of
+// any of its keys fetches the map again.
+func (c *Client) invalidate(loc master.TabletLocation) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for i, l := range c.locations {
+		if l == loc {
+			c.locations = append(c.locations[:i:i], c.locations[i+1:]...)
+			return
+		}
+	}
+}
+
+// post sends a JSON body and decodes the JSON response into out, if not nil.
+func (c *Client) post(ctx context.Context, loc master.TabletLocation, path string, in, out any) error {
+	data, err := json.Marshal(in)
+	if err != nil {
+		return err
+	}
+	resp, err := c.do(ctx, loc, http.MethodPost, path, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if out == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// get sends a query and decodes the JSON response into out.
+func (c *Client) get(ctx context.Context, loc master.TabletLocation, path string, q url.Values, out any) error {
+	resp, err := c.do(ctx, loc, http.MethodGet, path+"?"+q.Encode(), nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// do sends a request to the server of a tablet, addressing the tablet's table.
+// Error responses are returned as *Error.
+func (c *Client) do(ctx context.Context, loc master.TabletLocation, method, path string, body io.Reader) (*http.Response, error) {
+	if loc.Table != "" {
+		sep := "?"
+		if strings.Contains(path, "?") {
+			sep = "&"
+		}
+		path += sep + "table=" + url.QueryEscape(loc.Table)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, baseURL(loc.ServerID)+path, body)
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		if ctx.Err() == nil {
+			// The server may be gone; look the tablet up again next time.
+			c.invalidate(loc)
+		}
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		return nil, readError(resp)
+	}
+	return resp, nil
+}
+
+func readError(resp *http.Response) error {
+	msg, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+	return &Error{StatusCode: resp.StatusCode, Message: strings.TrimSpace(string(msg))}
+}
+
+// baseURL turns a "host:port" address into an HTTP URL; URLs are kept as they are.
+func baseURL(addr string) string {
+	if strings.Contains(addr, "://") {
+		return strings.TrimSuffix(addr, "/")
+	}
+	return "http://" + addr
+}
+
+// addReadOptions encodes version filters as the servers' query parameters.
+func addReadOptions(q url.Values, opts tablet.ReadOptions) error {
+	if opts.MaxVersions < 0 {
+		return fmt.Errorf("negative max versions %d", opts.MaxVersions)
+	}
+	for name, v := range map[string]int64{
+		"start":        opts.TimeRange.Start,
+		"end":          opts.TimeRange.End,
+		"max_versions": int64(opts.MaxVersions),
+		"as_of":        opts.AsOf,
+	} {
+		if v != 0 {
+			q.Set(name, strconv.FormatInt(v, 10))
+		}
+	}
+	return nil
+}
+
+// addRowFilter encodes a row filter as the servers' filter query parameter.
+func addRowFilter(q url.Values, f *tablet.RowFilter) error {
+	if f == nil {
+		return nil
+	}
+	if err := f.Validate(); err != nil {
+		return fmt.Errorf("invalid filter: %w", err)
+	}
+	data, err := json.Marshal(f)
+	if err != nil {
+		return err
+	}
+	q.Set("filter", string(data))
+	return nil
+}
@@ -0,0 +1,380 @@
+package client
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Gourab-18/google_big_table/pkg/master"
+	"github.com/Gourab-18/google_big_table/pkg/tablet"
+	"github.com/Gourab-18/google_big_table/pkg/tabletserver"
+)
+
+// testServer serves one tablet of the default table over HTTP.
+type testServer struct {
+	*tabletserver.TabletServer
+	URL      string
+	requests atomic.Int32
+}
+
+// newTestServer starts a tablet server serving [start, end) with the "cf" family.
+func newTestServer(t *testing.T, start, end string) *testServer {
+	t.Helper()
+	opts := tabletserver.DefaultOptions()
+	opts.Tablet.CompactionStrategy = nil
+	root := t.TempDir()
+	tb, err := tablet.NewTabletWithOptions(start, end, filepath.Join(root, "tablet"), opts.Tablet)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = tb.SetColumnFamily(tablet.ColumnFamily{Name: "cf"})
+	if cerr := tb.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	ts, err := tabletserver.NewTabletServerWithOptions(root, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { ts.Close() })
+
+	s := &testServer{TabletServer: ts}
+	mux := http.NewServeMux()
+	for path, h := range map[string]http.HandlerFunc{
+		"/mutate":            ts.HandleMutate,
+		"/row":               ts.HandleReadRow,
+		"/scan":              ts.HandleScan,
+		"/check-and-mutate":  ts.HandleCheckAndMutate,
+		"/read-modify-write": ts.HandleReadModifyWrite,
+	} {
+		mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			s.requests.Add(1)
+			h(w, r)
+		})
+	}
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	s.URL = srv.URL
+	return s
+}
+
+// value returns the latest value of cf:q of a row on the server, or "".
+func (s *testServer) value(t *testing.T, key string) string {
+	t.Helper()
+	v, err := s.Tablets[0].Read(key, "cf", "q")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v == nil {
+		return ""
+	}
+	return string(v.Value)
+}
+
+// location returns the location of the tablet [start, end) on s.
+func (s *testServer) location(start, end string) master.TabletLocation {
+	return master.TabletLocation{Table: master.DefaultTable, TabletID: start + "-" + end, StartKey: start, EndKey: end, ServerID: s.URL}
+}
+
+// fakeMaster serves a tablet map set by the test at /tablets, like a master
+// without METADATA.
+type fakeMaster struct {
+	URL     string
+	fetches atomic.Int32
+
+	mu        sync.Mutex
+	locations []master.TabletLocation
+}
+
+func newFakeMaster(t *testing.T, locations ...master.TabletLocation) *fakeMaster {
+	t.Helper()
+	m := &fakeMaster{locations: locations}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/root":
+			http.Error(w, "no METADATA table", http.StatusNotFound)
+		case "/tablets":
+			if table := r.URL.Query().Get("table"); table != master.DefaultTable {
+				http.Error(w, "unknown table "+table, http.StatusNotFound)
+				return
+			}
+			m.fetches.Add(1)
+			m.mu.Lock()
+			defer m.mu.Unlock()
+			json.NewEncoder(w).Encode(m.locations)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	m.URL = srv.URL
+	return m
+}
+
+func (m *fakeMaster) setLocations(locations ...master.TabletLocation) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.locations = locations
+}
+
+func set(key, value string) *tablet.RowMutation {
+	m := tablet.NewRowMutation(key)
+	m.AddSet("cf", "q", 1000, []byte(value))
+	return m
+}
+
+func mustApply(t *testing.T, c *Client, m *tablet.RowMutation) {
+	t.Helper()
+	if err := c.Apply(context.Background(), m); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestClientRoutesKeysToTheirTablets(t *testing.T) {
+	left, right := newTestServer(t, "", "m"), newTestServer(t, "m", "")
+	m := newFakeMaster(t, left.location("", "m"), right.location("m", ""))
+	c := NewClient(m.URL)
+
+	for _, key := range []string{"", "a", "lzz", "m", "z"} {
+		mustApply(t, c, set(key, "v-"+key))
+	}
+	for _, key := range []string{"", "a", "lzz"} {
+		if got := left.value(t, key); got != "v-"+key {
+			t.Errorf("%q on the left server = %q, want v-%s", key, got, key)
+		}
+	}
+	for _, key := range []string{"m", "z"} {
+		if got := right.value(t, key); got != "v-"+key {
+			t.Errorf("%q on the right server = %q, want v-%s", key, got, key)
+		}
+	}
+	if got := left.requests.Load() + right.requests.Load(); got != 5 {
+		t.Errorf("servers got %d requests, want one per mutation", got)
+	}
+	if got := m.fetches.Load(); got != 1 {
+		t.Errorf("the tablet map was fetched %d times, want once", got)
+	}
+}
+
+func TestClientOperations(t *testing.T) {
+	left, right := newTestServer(t, "", "m"), newTestServer(t, "m", "")
+	m := newFakeMaster(t, left.location("", "m"), right.location("m", ""))
+	c := NewClient(m.URL)
+	ctx := context.Background()
+	for _, key := range []string{"a", "b", "l", "m", "n", "z"} {
+		mustApply(t, c, set(key, "v-"+key))
+	}
+
+	t.Run("ReadRow", func(t *testing.T) {
+		row, err := c.ReadRow(ctx, "n", tablet.ReadFilter{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v := row.Get("cf", "q"); v == nil || string(v.Value) != "v-n" {
+			t.Errorf("n = %v, want v-n", v)
+		}
+		if row, err := c.ReadRow(ctx, "missing", tablet.ReadFilter{}); err != nil || row != nil {
+			t.Errorf("ReadRow of a missing row = %v, %v, want nil", row, err)
+		}
+		filter := tablet.ReadFilter{Filter: &tablet.RowFilter{StripValue: true}}
+		if row, err := c.ReadRow(ctx, "a", filter); err != nil || row == nil || len(row.Get("cf", "q").Value) != 0 {
+			t.Errorf("ReadRow with a filter = %v, %v, want a stripped cell", row, err)
+		}
+	})
+
+	t.Run("ReadRows", func(t *testing.T) {
+		read := func(start, end string, opts tablet.ScanOptions, stopAt string) []string {
+			var keys []string
+			err := c.ReadRows(ctx, start, end, opts, func(row *tablet.Row) bool {
+				keys = append(keys, row.Key)
+				return row.Key != stopAt
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			return keys
+		}
+		if got, want := read("", "", tablet.ScanOptions{}, ""), []string{"a", "b", "l", "m", "n", "z"}; !reflect.DeepEqual(got, want) {
+			t.Errorf("ReadRows of the table = %v, want %v", got, want)
+		}
+		if got, want := read("b", "n", tablet.ScanOptions{}, ""), []string{"b", "l", "m"}; !reflect.DeepEqual(got, want) {
+			t.Errorf("ReadRows [b, n) = %v, want %v", got, want)
+		}
+		if got, want := read("", "", tablet.ScanOptions{Limit: 4}, ""), []string{"a", "b", "l", "m"}; !reflect.DeepEqual(got, want) {
+			t.Errorf("ReadRows with a limit = %v, want %v", got, want)
+		}
+		if got, want := read("", "", tablet.ScanOptions{}, "m"), []string{"a", "b", "l", "m"}; !reflect.DeepEqual(got, want) {
+			t.Errorf("ReadRows stopped at m = %v, want %v", got, want)
+		}
+		filter := &tablet.RowFilter{ValueRegex: "v-[bz]"}
+		if got, want := read("", "", tablet.ScanOptions{Filter: filter}, ""), []string{"b", "z"}; !reflect.DeepEqual(got, want) {
+			t.Errorf("ReadRows with a filter = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("CheckAndMutate", func(t *testing.T) {
+		predicate := &tablet.RowFilter{ValueRegex: "v-z"}
+		matched, err := c.CheckAndMutate(ctx, "z", predicate, set("z", "matched"), set("z", "not matched"))
+		if err != nil || !matched {
+			t.Fatalf("CheckAndMutate = %v, %v, want a match", matched, err)
+		}
+		if got := right.value(t, "z"); got != "matched" {
+			t.Errorf("z = %q, want matched", got)
+		}
+		matched, err = c.CheckAndMutate(ctx, "a", predicate, set("a", "matched"), set("a", "not matched"))
+		if err != nil || matched {
+			t.Fatalf("CheckAndMutate = %v, %v, want no match", matched, err)
+		}
+		if got := left.value(t, "a"); got != "not matched" {
+			t.Errorf("a = %q, want not matched", got)
+		}
+	})
+
+	t.Run("ReadModifyWrite", func(t *testing.T) {
+		rmw := &tablet.ReadModifyWriteRow{RowKey: "x", Rules: []tablet.ReadModifyWriteRule{
+			{Type: tablet.ReadModifyWriteIncrement, Family: "cf", Qualifier: "n", IncrementAmount: 2},
+		}}
+		for want := int64(2); want <= 4; want += 2 {
+			row, err := c.ReadModifyWrite(ctx, rmw)
+			if err != nil {
+				t.Fatal(err)
+			}
+			v := row.Get("cf", "n")
+			if v == nil || len(v.Value) != 8 || int64(binary.BigEndian.Uint64(v.Value)) != want {
+				t.Errorf("increment returned %v, want %d", v, want)
+			}
+		}
+		_, err := c.ReadModifyWrite(ctx, &tablet.ReadModifyWriteRow{RowKey: "x"})
+		var e *Error
+		if !errors.As(err, &e) || e.StatusCode != http.StatusBadRequest {
+			t.Errorf("ReadModifyWrite without rules = %v, want a 400", err)
+		}
+	})
+}
+
+func TestClientRefreshesStaleLocations(t *testing.T) {
+	left, right := newTestServer(t, "", "m"), newTestServer(t, "m", "")
+	// The map still says the left server holds the whole table, as before a split.
+	m := newFakeMaster(t, left.location("", ""))
+	c := NewClient(m.URL)
+	mustApply(t, c, set("a", "v-a"))
+
+	m.setLocations(left.location("", "m"), right.location("m", ""))
+	mustApply(t, c, set("z", "v-z"))
+	if got := right.value(t, "z"); got != "v-z" {
+		t.Errorf("z on the right server = %q, want v-z", got)
+	}
+	if got := m.fetches.Load(); got != 2 {
+		t.Errorf("the tablet map was fetched %d times, want again after the stale location", got)
+	}
+
+	// The tablet moves to the other server.
+	moved := newTestServer(t, "m", "")
+	m.setLocations(left.location("", "m"), moved.location("m", ""))
+	right.Close()
+	row, err := c.ReadRow(context.Background(), "z", tablet.ReadFilter{})
+	if err != nil || row != nil {
+		t.Errorf("ReadRow from the server the tablet moved to = %v, %v, want the empty row", row, err)
+	}
+	if moved.requests.Load() != 1 {
+		t.Errorf("the new server got %d requests, want 1", moved.requests.Load())
+	}
+
+	// Scans refresh too, and resume where they stopped.
+	mustApply(t, c, set("n", "v-n"))
+	m.setLocations(left.location("", ""))
+	c = NewClient(m.URL)
+	mustApply(t, c, set("b", "v-b"))
+	m.setLocations(left.location("", "m"), moved.location("m", ""))
+	var keys []string
+	if err := c.ReadRows(context.Background(), "", "", tablet.ScanOptions{}, func(row *tablet.Row) bool {
+		keys = append(keys, row.Key)
+		return true
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"a", "b", "n"}; !reflect.DeepEqual(keys, want) {
+		t.Errorf("ReadRows over a stale map = %v, want %v", keys, want)
+	}
+}
+
+func TestClientGivesUpOnAMapThatStaysStale(t *testing.T) {
+	left := newTestServer(t, "", "m")
+	m := newFakeMaster(t, left.location("", ""))
+	c := NewClient(m.URL)
+
+	err := c.Apply(context.Background(), set("z", "v"))
+	var e *Error
+	if !errors.As(err, &e) || e.StatusCode != statusNotServed {
+		t.Fatalf("Apply = %v, want the server's routing error", err)
+	}
+	if got := m.fetches.Load(); got != maxAttempts {
+		t.Errorf("the tablet map was fetched %d times, want %d", got, maxAttempts)
+	}
+
+	// Errors that are not about routing are returned as they are, without a refresh.
+	m.fetches.Store(0)
+	bad := tablet.NewRowMutation("a")
+	bad.AddSet("nope", "q", 1, []byte("v"))
+	if err := c.Apply(context.Background(), bad); !errors.As(err, &e) || e.StatusCode != http.StatusBadRequest {
+		t.Errorf("Apply to an unknown family = %v, want a 400", err)
+	}
+	if got := m.fetches.Load(); got != 1 {
+		t.Errorf("the tablet map was fetched %d times, want once", got)
+	}
+}
+
+func TestClientHonoursContextCancellation(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The request context only notices the client going away once the
+		// body has been read.
+		io.Copy(io.Discard, r.Body)
+		select {
+		case <-r.Context().Done():
+		case <-release:
+		}
+	}))
+	defer slow.Close()
+	m := newFakeMaster(t, master.TabletLocation{Table: master.DefaultTable, TabletID: "t", ServerID: slow.URL})
+	c := NewClient(m.URL)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	if err := c.Apply(ctx, set("a", "v")); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Apply past the deadline = %v, want context.DeadlineExceeded", err)
+	}
+	if d := time.Since(start); d > 2*time.Second {
+		t.Errorf("Apply returned after %v, want right after the deadline", d)
+	}
+	// The location is not blamed for the caller giving up.
+	if _, ok := c.cached("a"); !ok {
+		t.Error("a cancelled request dropped the location")
+	}
+
+	ctx, cancel = context.WithCancel(context.Background())
+	cancel()
+	if _, err := c.ReadRow(ctx, "a", tablet.ReadFilter{}); !errors.Is(err, context.Canceled) {
+		t.Errorf("ReadRow with a cancelled context = %v, want context.Canceled", err)
+	}
+	if err := c.ReadRows(ctx, "", "", tablet.ScanOptions{}, func(*tablet.Row) bool { return true }); !errors.Is(err, context.Canceled) {
+		t.Errorf("ReadRows with a cancelled context = %v, want context.Canceled", err)
+	}
+	if got := m.fetches.Load(); got != 1 {
+		t.Errorf("the tablet map was fetched %d times, want once", got)
+	}
+}
@@ -0,0 +1,282 @@
+package lockservice
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Handler returns the HTTP API of the service:
+//
+//	POST   /session             open a session; responds {"ID", "TTL"}
+//	POST   /keepalive?session=  extend a session
+//	DELETE /session?session=    close a session
+//	POST   /acquire?session=&path=  take a lock; the body is its contents; responds {"Acquired"}
+//	POST   /release?session=&path=  give up a lock
+//	GET    /file?path=          a File, or 404
+//	GET    /list?prefix=        {"Files", "Version"}
+//	GET    /watch?prefix=&since=    long poll; responds {"Version"}
+//
+// Requests on expired sessions get 410 Gone.
+func (s *Service) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/session", s.handleSession)
+	mux.HandleFunc("/keepalive", s.handleKeepAlive)
+	mux.HandleFunc("/acquire", s.handleAcquire)
+	mux.HandleFunc("/release", s.handleRelease)
+	mux.HandleFunc("/file", s.handleFile)
+	mux.HandleFunc("/list", s.handleList)
+	mux.HandleFunc("/watch", s.handleWatch)
+	return mux
+}
+
+// Serve starts the lock service HTTP server.
+func (s *Service) Serve(addr string) error {
+	return http.ListenAndServe(addr, s.Handler())
+}
+
+// writeError responds with the status matching err.
+func writeError(w http.ResponseWriter, err error) {
+	if errors.Is(err, ErrSessionExpired) {
+		http.Error(w, err.Error(), http.StatusGone)
+		return
+	}
+	http.Error(w, err.Error(), http.StatusInternalServerError)
+}
+
+func (s *Service) handleSession(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodPost:
+		id, ttl, err := s.OpenSession()
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		json.NewEncoder(w).Encode(struct {
+			ID  string
+			TTL time.Duration
+		}{id, ttl})
+	case http.MethodDelete:
+		if err := s.CloseSession(r.URL.Query().Get("session")); err != nil {
+			writeError(w, err)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func (s *Service) handleKeepAlive(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if err := s.KeepAlive(r.URL.Query().Get("session")); err != nil {
+		writeError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Service) handleAcquire(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	contents, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	q := r.URL.Query()
+	ok, err := s.Acquire(q.Get("session"), q.Get("path"), contents)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	json.NewEncoder(w).Encode(struct{ Acquired bool }{ok})
+}
+
+func (s *Service) handleRelease(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	q := r.URL.Query()
+	if err := s.Release(q.Get("session"), q.Get("path")); err != nil {
+		writeError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Service) handleFile(w http.ResponseWriter, r *http.Request) {
+	f, err := s.Get(r.URL.Query().Get("path"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	if f == nil {
+		http.Error(w, "Not found", http.StatusNotFound)
+		return
+	}
+	json.NewEncoder(w).Encode(f)
+}
+
+func (s *Service) handleList(w http.ResponseWriter, r *http.Request) {
+	files, version, err := s.List(r.URL.Query().Get("prefix"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	json.NewEncoder(w).Encode(struct {
+		Files   []File
+		Version uint64
+	}{files, version})
+}
+
+func (s *Service) handleWatch(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	since, err := strconv.ParseUint(q.Get("since"), 10, 64)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("invalid since: %v", err), http.StatusBadRequest)
+		return
+	}
+	version, err := s.Watch(r.Context(), q.Get("prefix"), since)
+	if err != nil {
+		return // The client went away
+	}
+	json.NewEncoder(w).Encode(struct{ Version uint64 }{version})
+}
+
+// Client is a Locker talking to a lock service over HTTP. Its methods are
+// documented at Locker.
+type Client struct {
+	// HTTPClient sends the requests. Watches wait as long as their context
+	// allows, so it should have no timeout. It defaults to http.DefaultClient.
+	HTTPClient *http.Client
+
+	addr string
+}
+
+// NewClient creates a client of the lock service at addr ("host:port" or a URL).
+func NewClient(addr string) *Client {
+	return &Client{HTTPClient: http.DefaultClient, addr: addr}
+}
+
+// requestTimeout bounds every request but watches.
+const requestTimeout = 5 * time.Second
+
+func (c *Client) OpenSession() (string, time.Duration, error) {
+	var resp struct {
+		ID  string
+		TTL time.Duration
+	}
+	err := c.callTimeout(http.MethodPost, "/session", nil, nil, &resp)
+	return resp.ID, resp.TTL, err
+}
+
+func (c *Client) KeepAlive(session string) error {
+	return c.callTimeout(http.MethodPost, "/keepalive", url.Values{"session": {session}}, nil, nil)
+}
+
+func (c *Client) CloseSession(session string) error {
+	return c.callTimeout(http.MethodDelete, "/session", url.Values{"session": {session}}, nil, nil)
+}
+
+func (c *Client) Acquire(session, path string, contents []byte) (bool, error) {
+	var resp struct{ Acquired bool }
+	err := c.callTimeout(http.MethodPost, "/acquire", url.Values{"session": {session}, "path": {path}}, contents, &resp)
+	return resp.Acquired, err
+}
+
+func (c *Client) Release(session, path string) error {
+	return c.callTimeout(http.MethodPost, "/release", url.Values{"session": {session}, "path": {path}}, nil, nil)
+}
+
+func (c *Client) Get(path string) (*File, error) {
+	var f File
+	err := c.callTimeout(http.MethodGet, "/file", url.Values{"path": {path}}, nil, &f)
+	if errors.Is(err, errNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &f, nil
+}
+
+func (c *Client) List(prefix string) ([]File, uint64, error) {
+	var resp struct {
+		Files   []File
+		Version uint64
+	}
+	err := c.callTimeout(http.MethodGet, "/list", url.Values{"prefix": {prefix}}, nil, &resp)
+	return resp.Files, resp.Version, err
+}
+
+func (c *Client) Watch(ctx context.Context, prefix string, since uint64) (uint64, error) {
+	var resp struct{ Version uint64 }
+	q := url.Values{"prefix": {prefix}, "since": {strconv.FormatUint(since, 10)}}
+	err := c.call(ctx, http.MethodGet, "/watch", q, nil, &resp)
+	return resp.Version, err
+}
+
+// errNotFound is returned by call for 404 responses.
+var errNotFound = errors.New("not found")
+
+// callTimeout is call bounded by requestTimeout.
+func (c *Client) callTimeout(method, path string, q url.Values, body []byte, out any) error {
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+	return c.call(ctx, method, path, q, body, out)
+}
+
+// call sends a request and decodes the JSON response into out, if not nil.
+func (c *Client) call(ctx context.Context, method, path string, q url.Values, body []byte, out any) error {
+	u := baseURL(c.addr) + path
+	if q != nil {
+		u += "?" + q.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, method, u, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusGone:
+		return ErrSessionExpired
+	case http.StatusNotFound:
+		return errNotFound
+	default:
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return fmt.Errorf("lock service returned %s: %s", resp.Status, strings.TrimSpace(string(msg)))
+	}
+	if out == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// baseURL turns a "host:port" address into an HTTP URL; URLs are kept as they are.
+func baseURL(addr string) string {
+	if strings.Contains(addr, "://") {
+		return strings.TrimSuffix(addr, "/")
+	}
+	return "http://" + addr
+}
@@ -0,0 +1,383 @@
+// Package lockservice is a small Chubby-style lock service.
+//
+// Clients open sessions and keep them alive. A session can hold ephemeral lock
+// files, which disappear when it releases them or when it expires, and can
+// watch a path prefix for changes. The Service runs in-process and keeps its
+// state in a local file; Serve exposes it over HTTP, and Client talks to it.
+// Both implement Locker.
+package lockservice
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultSessionTTL is the default Options.SessionTTL.
+const DefaultSessionTTL = 10 * time.Second
+
+// ErrSessionExpired is returned for sessions the service does not know,
+// because they expired or were closed. Their locks are gone.
+var ErrSessionExpired = errors.New("session expired")
+
+// Options configures a Service.
+type Options struct {
+	// Path is the file the service keeps its state in. Empty keeps it in memory.
+	Path string
+	// SessionTTL is how long a session lives without a keep-alive.
+	SessionTTL time.Duration
+}
+
+// File is a lock file: a path held by a session, with contents set by it.
+type File struct {
+	Path     string
+	Contents []byte
+	// Session is the session holding the lock; the file goes away with it.
+	Session string
+	// Version is the service version at which the file was last written.
+	Version uint64
+}
+
+// Service is an in-process lock service. It is safe for concurrent use.
+type Service struct {
+	mu      sync.Mutex
+	options Options
+
+	sessions    map[string]time.Time // Session ID to expiry
+	nextSession uint64
+	files       map[string]*File
+	// version counts changes to files. modified records the version of the
+	// last change of every path, including deletions, for watches.
+	version  uint64
+	modified map[string]uint64
+	// changed is closed and replaced on every change, waking up watches.
+	changed chan struct{}
+
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+// state is the part of the service persisted to Options.Path.
+type state struct {
+	Version     uint64
+	NextSession uint64
+	Sessions    []string
+	Files       []*File
+}
+
+// NewService creates a lock service, reloading the state stored at opts.Path.
+// Reloaded sessions get a whole TTL to send a keep-alive, so clients survive a
+// restart of the service. It expires sessions in the background until Close.
+func NewService(opts Options) (*Service, error) {
+	if opts.SessionTTL <= 0 {
+		opts.SessionTTL = DefaultSessionTTL
+	}
+	s := &Service{
+		options:  opts,
+		sessions: make(map[string]time.Time),
+		files:    make(map[string]*File),
+		modified: make(map[string]uint64),
+		changed:  make(chan struct{}),
+		stop:     make(chan struct{}),
+	}
+	if err := s.load(); err != nil {
+		return nil, err
+	}
+	s.wg.Add(1)
+	go s.expiryLoop()
+	return s, nil
+}
+
+// Close stops expiring sessions. The state stays in Options.Path.
+func (s *Service) Close() error {
+	close(s.stop)
+	s.wg.Wait()
+	return nil
+}
+
+// OpenSession starts a session and returns its ID and TTL.
+func (s *Service) OpenSession() (string, time.Duration, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.nextSession++
+	id := strconv.FormatUint(s.nextSession, 10)
+	s.sessions[id] = time.Now().Add(s.options.SessionTTL)
+	if err := s.saveLocked(); err != nil {
+		delete(s.sessions, id)
+		return "", 0, err
+	}
+	return id, s.options.SessionTTL, nil
+}
+
+// KeepAlive extends a session by a whole TTL.
+func (s *Service) KeepAlive(session string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.sessions[session]; !ok {
+		return ErrSessionExpired
+	}
+	s.sessions[session] = time.Now().Add(s.options.SessionTTL)
+	return nil
+}
+
+// CloseSession ends a session and releases its locks.
+func (s *Service) CloseSession(session string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.sessions[session]; !ok {
+		return nil
+	}
+	s.endSessionLocked(session)
+	return s.saveLocked()
+}
+
+// Acquire takes the lock at path for a session, with the given contents.
+// It reports false if another session holds it. A session acquiring a lock it
+// already holds replaces the contents.
+func (s *Service) Acquire(session, path string, contents []byte) (bool, error) {
+	if path == "" {
+		return false, fmt.Errorf("empty lock path")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.sessions[session]; !ok {
+		return false, ErrSessionExpired
+	}
+	if f, ok := s.files[path]; ok && f.Session != session {
+		return false, nil
+	}
+	s.version++
+	s.files[path] = &File{
+		Path:     path,
+		Contents: append([]byte(nil), contents...),
+		Session:  session,
+		Version:  s.version,
+	}
+	s.notifyLocked(path)
+	if err := s.saveLocked(); err != nil {
+		// Not granted unless persisted.
+		s.deleteLocked(path)
+		return false, err
+	}
+	return true, nil
+}
+
+// Release gives up a lock held by a session. Releasing a lock the session
+// does not hold does nothing.
+func (s *Service) Release(session, path string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.sessions[session]; !ok {
+		return ErrSessionExpired
+	}
+	if f, ok := s.files[path]; !ok || f.Session != session {
+		return nil
+	}
+	s.deleteLocked(path)
+	return s.saveLocked()
+}
+
+// Get returns the lock file at path, or nil if nobody holds it.
+func (s *Service) Get(path string) (*File, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f, ok := s.files[path]
+	if !ok {
+		return nil, nil
+	}
+	c := *f
+	return &c, nil
+}
+
+// List returns the lock files whose path starts with prefix, sorted by path,
+// and the current version, from which to Watch for later changes.
+func (s *Service) List(prefix string) ([]File, uint64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	files := make([]File, 0)
+	for path, f := range s.files {
+		if strings.HasPrefix(path, prefix) {
+			files = append(files, *f)
+		}
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].Path < files[j].Path })
+	return files, s.version, nil
+}
+
+// Watch blocks until a file whose path starts with prefix is created, changed
+// or deleted after version since, and returns the current version.
+// Like Chubby events, watches only tell that something changed: callers read
+// the files again.
+func (s *Service) Watch(ctx context.Context, prefix string, since uint64) (uint64, error) {
+	for {
+		s.mu.Lock()
+		fired := false
+		for path, v := range s.modified {
+			if v > since && strings.HasPrefix(path, prefix) {
+				fired = true
+				break
+			}
+		}
+		version, changed := s.version, s.changed
+		s.mu.Unlock()
+		if fired {
+			return version, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return version, ctx.Err()
+		case <-changed:
+		}
+	}
+}
+
+// expiryLoop ends the sessions that missed their keep-alives until Close.
+func (s *Service) expiryLoop() {
+	defer s.wg.Done()
+	ticker := time.NewTicker(min(s.options.SessionTTL/4, time.Second))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.stop:
+			return
+		case now := <-ticker.C:
+			s.expire(now)
+		}
+	}
+}
+
+// expire ends every session whose TTL ran out before now.
+func (s *Service) expire(now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var expired []string
+	for id, expiry := range s.sessions {
+		if now.After(expiry) {
+			expired = append(expired, id)
+		}
+	}
+	if len(expired) == 0 {
+		return
+	}
+	sort.Strings(expired)
+	for _, id := range expired {
+		fmt.Printf("Lock service session %s expired\n", id)
+		s.endSessionLocked(id)
+	}
+	if err := s.saveLocked(); err != nil {
+		fmt.Printf("Failed to save lock service state: %v\n", err)
+	}
+}
+
+// endSessionLocked forgets a session and deletes its lock files.
+// It assumes the lock is held.
+func (s *Service) endSessionLocked(session string) {
+	delete(s.sessions, session)
+	for path, f := range s.files {
+		if f.Session == session {
+			s.deleteLocked(path)
+		}
+	}
+}
+
+// deleteLocked deletes a lock file. It assumes the lock is held.
+func (s *Service) deleteLocked(path string) {
+	delete(s.files, path)
+	s.version++
+	s.notifyLocked(path)
+}
+
+// notifyLocked records a change of path at the current version and wakes up
+// watches. It assumes the lock is held.
+func (s *Service) notifyLocked(path string) {
+	s.modified[path] = s.version
+	close(s.changed)
+	s.changed = make(chan struct{})
+}
+
+// load reads the state stored at Options.Path, if any.
+func (s *Service) load() error {
+	if s.options.Path == "" {
+		return nil
+	}
+	data, err := os.ReadFile(s.options.Path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	var st state
+	if err := json.Unmarshal(data, &st); err != nil {
+		return fmt.Errorf("failed to parse lock service state: %w", err)
+	}
+
+	s.version = st.Version
+	s.nextSession = st.NextSession
+	expiry := time.Now().Add(s.options.SessionTTL)
+	for _, id := range st.Sessions {
+		s.sessions[id] = expiry
+	}
+	for _, f := range st.Files {
+		s.files[f.Path] = f
+		s.modified[f.Path] = f.Version
+	}
+	return nil
+}
+
+// saveLocked writes the state to Options.Path, replacing the previous one
+// atomically. It assumes the lock is held.
+func (s *Service) saveLocked() error {
+	if s.options.Path == "" {
+		return nil
+	}
+	st := state{Version: s.version, NextSession: s.nextSession}
+	for id := range s.sessions {
+		st.Sessions = append(st.Sessions, id)
+	}
+	sort.Strings(st.Sessions)
+	for _, f := range s.files {
+		st.Files = append(st.Files, f)
+	}
+	sort.Slice(st.Files, func(i, j int) bool { return st.Files[i].Path < st.Files[j].Path })
+
+	data, err := json.MarshalIndent(st, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := s.options.Path + ".tmp"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	// Locks must not come back after a crash once released, nor vanish once granted.
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp, s.options.Path)
+}
@@ -0,0 +1,254 @@
+package lockservice
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func newTestService(t *testing.T, opts Options) *Service {
+	t.Helper()
+	s, err := NewService(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { s.Close() })
+	return s
+}
+
+// forEachLocker runs f against a service, both in-process and over HTTP.
+func forEachLocker(t *testing.T, opts Options, f func(t *testing.T, s *Service, l Locker)) {
+	t.Run("service", func(t *testing.T) {
+		s := newTestService(t, opts)
+		f(t, s, s)
+	})
+	t.Run("client", func(t *testing.T) {
+		s := newTestService(t, opts)
+		srv := httptest.NewServer(s.Handler())
+		t.Cleanup(srv.Close)
+		f(t, s, NewClient(srv.URL))
+	})
+}
+
+func openSession(t *testing.T, l Locker) string {
+	t.Helper()
+	id, _, err := l.OpenSession()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return id
+}
+
+func acquire(t *testing.T, l Locker, session, path string) bool {
+	t.Helper()
+	ok, err := l.Acquire(session, path, []byte(session))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return ok
+}
+
+// holder returns the session holding the lock at path, or "".
+func holder(t *testing.T, l Locker, path string) string {
+	t.Helper()
+	f, err := l.Get(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f == nil {
+		return ""
+	}
+	return f.Session
+}
+
+func TestLocksAreExclusive(t *testing.T) {
+	forEachLocker(t, Options{}, func(t *testing.T, s *Service, l Locker) {
+		a, b := openSession(t, l), openSession(t, l)
+		if !acquire(t, l, a, "/x") {
+			t.Fatal("first Acquire failed")
+		}
+		if acquire(t, l, b, "/x") {
+			t.Error("a second session acquired a held lock")
+		}
+		if ok, err := l.Acquire(a, "/x", []byte("new")); err != nil || !ok {
+			t.Errorf("holder re-acquiring = %v, %v", ok, err)
+		}
+		if f, _ := l.Get("/x"); f == nil || string(f.Contents) != "new" {
+			t.Errorf("file after re-acquiring = %+v, want the new contents", f)
+		}
+
+		// Releasing a lock held by someone else does nothing.
+		if err := l.Release(b, "/x"); err != nil {
+			t.Fatal(err)
+		}
+		if got := holder(t, l, "/x"); got != a {
+			t.Fatalf("/x is held by %q after another session released it, want %q", got, a)
+		}
+		if err := l.Release(a, "/x"); err != nil {
+			t.Fatal(err)
+		}
+		if got := holder(t, l, "/x"); got != "" {
+			t.Fatalf("/x is held by %q after its release", got)
+		}
+		if !acquire(t, l, b, "/x") {
+			t.Error("released lock could not be acquired")
+		}
+	})
+}
+
+func TestClosingSessionReleasesLocks(t *testing.T) {
+	forEachLocker(t, Options{}, func(t *testing.T, s *Service, l Locker) {
+		a := openSession(t, l)
+		acquire(t, l, a, "/dir/1")
+		acquire(t, l, a, "/dir/2")
+		if err := l.CloseSession(a); err != nil {
+			t.Fatal(err)
+		}
+		if files, _, err := l.List("/dir/"); err != nil || len(files) != 0 {
+			t.Errorf("List after closing the session = %v, %v, want nothing", files, err)
+		}
+		if err := l.KeepAlive(a); !errors.Is(err, ErrSessionExpired) {
+			t.Errorf("KeepAlive of a closed session = %v, want ErrSessionExpired", err)
+		}
+		if _, err := l.Acquire(a, "/dir/1", nil); !errors.Is(err, ErrSessionExpired) {
+			t.Errorf("Acquire in a closed session = %v, want ErrSessionExpired", err)
+		}
+	})
+}
+
+func TestSessionExpiry(t *testing.T) {
+	forEachLocker(t, Options{SessionTTL: time.Hour}, func(t *testing.T, s *Service, l Locker) {
+		a, b := openSession(t, l), openSession(t, l)
+		acquire(t, l, a, "/a")
+		acquire(t, l, b, "/b")
+
+		s.expire(time.Now().Add(30 * time.Minute))
+		if holder(t, l, "/a") != a {
+			t.Fatal("session expired before its TTL")
+		}
+		// 50 minutes later, a sends a keep-alive and b does not.
+		s.mu.Lock()
+		for id, expiry := range s.sessions {
+			s.sessions[id] = expiry.Add(-50 * time.Minute)
+		}
+		s.mu.Unlock()
+		if err := l.KeepAlive(a); err != nil {
+			t.Fatal(err)
+		}
+		// Only the session that missed its keep-alive expires, with its locks.
+		s.expire(time.Now().Add(30 * time.Minute))
+		if holder(t, l, "/a") != a {
+			t.Error("kept alive session expired")
+		}
+		if holder(t, l, "/b") != "" {
+			t.Error("lock of an expired session is still held")
+		}
+		if err := l.KeepAlive(b); !errors.Is(err, ErrSessionExpired) {
+			t.Errorf("KeepAlive of an expired session = %v, want ErrSessionExpired", err)
+		}
+	})
+}
+
+func TestExpiryLoop(t *testing.T) {
+	s := newTestService(t, Options{SessionTTL: 100 * time.Millisecond})
+	a := openSession(t, s)
+	acquire(t, s, a, "/a")
+	deadline := time.Now().Add(5 * time.Second)
+	for holder(t, s, "/a") != "" {
+		if time.Now().After(deadline) {
+			t.Fatal("lock of a silent session is still held")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestWatch(t *testing.T) {
+	forEachLocker(t, Options{SessionTTL: time.Hour}, func(t *testing.T, s *Service, l Locker) {
+		a := openSession(t, l)
+		_, version, err := l.List("/dir/")
+		if err != nil {
+			t.Fatal(err)
+		}
+		watch := func() <-chan uint64 {
+			fired := make(chan uint64, 1)
+			go func() {
+				v, err := l.Watch(context.Background(), "/dir/", version)
+				if err != nil {
+					t.Error(err)
+				}
+				fired <- v
+			}()
+			return fired
+		}
+		wait := func(fired <-chan uint64, what string) {
+			t.Helper()
+			select {
+			case version = <-fired:
+			case <-time.After(5 * time.Second):
+				t.Fatalf("watch did not fire on %s", what)
+			}
+		}
+
+		fired := watch()
+		acquire(t, l, a, "/other")
+		select {
+		case <-fired:
+			t.Fatal("watch fired on a change outside its prefix")
+		case <-time.After(50 * time.Millisecond):
+		}
+		acquire(t, l, a, "/dir/1")
+		wait(fired, "acquire")
+
+		fired = watch()
+		if err := l.Release(a, "/dir/1"); err != nil {
+			t.Fatal(err)
+		}
+		wait(fired, "release")
+
+		acquire(t, l, a, "/dir/2")
+		fired = watch()
+		s.expire(time.Now().Add(2 * time.Hour))
+		wait(fired, "expiry")
+
+		// Changes made before the watch started fire it right away.
+		b := openSession(t, l)
+		acquire(t, l, b, "/dir/3")
+		wait(watch(), "an earlier change")
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		if _, err := l.Watch(ctx, "/dir/", version); err == nil {
+			t.Error("watch without changes returned without error")
+		}
+	})
+}
+
+func TestStateSurvivesRestart(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "locks.json")
+	s, err := NewService(Options{Path: path})
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := openSession(t, s)
+	acquire(t, s, a, "/a")
+	b := openSession(t, s)
+	acquire(t, s, b, "/b")
+	if err := s.CloseSession(b); err != nil {
+		t.Fatal(err)
+	}
+	s.Close()
+
+	s = newTestService(t, Options{Path: path})
+	if holder(t, s, "/a") != a || holder(t, s, "/b") != "" {
+		t.Errorf("locks after restart: /a by %q, /b by %q; want only /a by %q", holder(t, s, "/a"), holder(t, s, "/b"), a)
+	}
+	if err := s.KeepAlive(a); err != nil {
+		t.Errorf("KeepAlive after restart = %v", err)
+	}
+	if c := openSession(t, s); c == a || c == b {
+		t.Errorf("session ID %s was reused", c)
+	}
+}
@@ -0,0 +1,173 @@
+package lockservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Locker is the lock service API, served in-process by Service and remotely
+// by Client.
+type Locker interface {
+	// OpenSession starts a session and returns its ID and TTL.
+	OpenSession() (string, time.Duration, error)
+	// KeepAlive extends a session by a whole TTL.
+	KeepAlive(session string) error
+	// CloseSession ends a session and releases its locks.
+	CloseSession(session string) error
+	// Acquire takes the lock at path for a session, reporting false if
+	// another session holds it.
+	Acquire(session, path string, contents []byte) (bool, error)
+	// Release gives up a lock held by a session.
+	Release(session, path string) error
+	// Get returns the lock file at path, or nil if nobody holds it.
+	Get(path string) (*File, error)
+	// List returns the lock files under prefix and the current version.
+	List(prefix string) ([]File, uint64, error)
+	// Watch blocks until a file under prefix changes after version since.
+	Watch(ctx context.Context, prefix string, since uint64) (uint64, error)
+}
+
+var (
+	_ Locker = (*Service)(nil)
+	_ Locker = (*Client)(nil)
+)
+
+// Session is an open session that is kept alive in the background.
+type Session struct {
+	ID string
+
+	locker Locker
+	ttl    time.Duration
+
+	done     chan struct{}
+	doneOnce sync.Once
+	stop     chan struct{}
+	wg       sync.WaitGroup
+}
+
+// NewSession opens a session and keeps it alive until Close or until it is lost.
+func NewSession(l Locker) (*Session, error) {
+	openedAt := time.Now()
+	id, ttl, err := l.OpenSession()
+	if err != nil {
+		return nil, err
+	}
+	s := &Session{
+		ID:     id,
+		locker: l,
+		ttl:    ttl,
+		done:   make(chan struct{}),
+		stop:   make(chan struct{}),
+	}
+	s.wg.Add(1)
+	go s.keepAliveLoop(openedAt)
+	return s, nil
+}
+
+// Done is closed when the session is lost: the service expired it, or it
+// could not be kept alive for a whole TTL, after which the service may have
+// expired it. Its locks must be considered lost from then on.
+func (s *Session) Done() <-chan struct{} {
+	return s.done
+}
+
+// Acquire takes the lock at path for the session.
+func (s *Session) Acquire(path string, contents []byte) (bool, error) {
+	return s.locker.Acquire(s.ID, path, contents)
+}
+
+// Release gives up a lock held by the session.
+func (s *Session) Release(path string) error {
+	return s.locker.Release(s.ID, path)
+}
+
+// AcquireWait takes the lock at path, waiting for its holder to give it up.
+// It fails if ctx is done or the session is lost first.
+func (s *Session) AcquireWait(ctx context.Context, path string, contents []byte) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		select {
+		case <-s.done:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	for {
+		ok, err := s.Acquire(path, contents)
+		if err != nil {
+			return err
+		}
+		if ok {
+			return nil
+		}
+		f, err := s.locker.Get(path)
+		if err != nil {
+			return err
+		}
+		if f == nil {
+			continue // Released in the meantime
+		}
+		if _, err := s.locker.Watch(ctx, path, f.Version); err != nil {
+			select {
+			case <-s.done:
+				return ErrSessionExpired
+			default:
+				return err
+			}
+		}
+	}
+}
+
+// Close stops the keep-alives and ends the session, releasing its locks.
+func (s *Session) Close() error {
+	close(s.stop)
+	s.wg.Wait()
+	s.lose()
+	return s.locker.CloseSession(s.ID)
+}
+
+func (s *Session) lose() {
+	s.doneOnce.Do(func() { close(s.done) })
+}
+
+// keepAliveLoop sends a keep-alive every third of the TTL until Close.
+func (s *Session) keepAliveLoop(lastAck time.Time) {
+	defer s.wg.Done()
+	interval := s.ttl / 3
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.stop:
+			return
+		case <-ticker.C:
+		}
+
+		sentAt := time.Now()
+		err := s.locker.KeepAlive(s.ID)
+		switch {
+		case errors.Is(err, ErrSessionExpired):
+			fmt.Printf("Lock service session %s expired\n", s.ID)
+			s.lose()
+			return
+		case err != nil:
+			fmt.Printf("Lock service keep-alive failed: %v\n", err)
+			// Give the session up before the service can expire it, allowing for one tick.
+			if time.Since(lastAck) > s.ttl-interval {
+				fmt.Printf("Lock service session %s lost\n", s.ID)
+				s.lose()
+				return
+			}
+		default:
+			// The service extends the session when it receives the keep-alive,
+			// so ours ends no later than theirs if measured from before sending it.
+			lastAck = sentAt
+		}
+	}
+}
@@ -0,0 +1,147 @@
+package lockservice
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// partitionedLocker is a Locker whose keep-alives fail while cut off.
+type partitionedLocker struct {
+	Locker
+	cut atomic.Bool
+}
+
+func (l *partitionedLocker) KeepAlive(session string) error {
+	if l.cut.Load() {
+		return errors.New("network partition")
+	}
+	return l.Locker.KeepAlive(session)
+}
+
+func newTestSession(t *testing.T, l Locker) *Session {
+	t.Helper()
+	s, err := NewSession(l)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { s.Close() })
+	return s
+}
+
+// waitLost waits for a session to be lost.
+func waitLost(t *testing.T, s *Session) {
+	t.Helper()
+	select {
+	case <-s.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatalf("session %s was not lost", s.ID)
+	}
+}
+
+func TestSessionIsKeptAlive(t *testing.T) {
+	svc := newTestService(t, Options{SessionTTL: 100 * time.Millisecond})
+	s, err := NewSession(svc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := s.Acquire("/a", nil); err != nil || !ok {
+		t.Fatalf("Acquire = %v, %v", ok, err)
+	}
+	time.Sleep(500 * time.Millisecond)
+	select {
+	case <-s.Done():
+		t.Fatal("session was lost")
+	default:
+	}
+	if holder(t, svc, "/a") != s.ID {
+		t.Error("lock of a live session was released")
+	}
+
+	if err := s.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if holder(t, svc, "/a") != "" {
+		t.Error("closing the session kept its lock")
+	}
+}
+
+func TestSessionLostWhenExpired(t *testing.T) {
+	svc := newTestService(t, Options{SessionTTL: 300 * time.Millisecond})
+	s := newTestSession(t, svc)
+	svc.expire(time.Now().Add(time.Second))
+	waitLost(t, s)
+}
+
+func TestSessionLostBeforeServiceExpiresIt(t *testing.T) {
+	svc := newTestService(t, Options{SessionTTL: 300 * time.Millisecond})
+	l := &partitionedLocker{Locker: svc}
+	s := newTestSession(t, l)
+	if ok, err := s.Acquire("/a", nil); err != nil || !ok {
+		t.Fatalf("Acquire = %v, %v", ok, err)
+	}
+
+	l.cut.Store(true)
+	waitLost(t, s)
+	// The holder must learn it lost the lock before anybody else can take it.
+	if holder(t, svc, "/a") != s.ID {
+		t.Error("the service released the lock before the session was lost")
+	}
+}
+
+func TestAcquireWait(t *testing.T) {
+	svc := newTestService(t, Options{SessionTTL: 300 * time.Millisecond})
+	a, err := NewSession(svc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := newTestSession(t, svc)
+	if ok, err := a.Acquire("/lock", []byte("a")); err != nil || !ok {
+		t.Fatalf("Acquire = %v, %v", ok, err)
+	}
+
+	acquired := make(chan error, 1)
+	go func() { acquired <- b.AcquireWait(context.Background(), "/lock", []byte("b")) }()
+	select {
+	case err := <-acquired:
+		t.Fatalf("AcquireWait returned %v while the lock is held", err)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	a.Close()
+	select {
+	case err := <-acquired:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("AcquireWait did not wake up when the lock was released")
+	}
+	if holder(t, svc, "/lock") != b.ID {
+		t.Error("waiting session does not hold the lock")
+	}
+}
+
+func TestAcquireWaitFailsWhenSessionLost(t *testing.T) {
+	svc := newTestService(t, Options{SessionTTL: 300 * time.Millisecond})
+	a := newTestSession(t, svc)
+	if ok, err := a.Acquire("/lock", nil); err != nil || !ok {
+		t.Fatalf("Acquire = %v, %v", ok, err)
+	}
+	l := &partitionedLocker{Locker: svc}
+	b := newTestSession(t, l)
+
+	acquired := make(chan error, 1)
+	go func() { acquired <- b.AcquireWait(context.Background(), "/lock", nil) }()
+	l.cut.Store(true)
+	select {
+	case err := <-acquired:
+		if !errors.Is(err, ErrSessionExpired) {
+			t.Errorf("AcquireWait = %v, want ErrSessionExpired", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("AcquireWait kept waiting in a lost session")
+	}
+}
@@ -0,0 +1,168 @@
+package master
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"time"
+
+	"github.com/Gourab-18/google_big_table/pkg/lockservice"
+)
+
+// MasterLockPath is the lock held by the active master. Its contents are the
+// master's address.
+const MasterLockPath = "/bigtable/master"
+
+// ServerLockDir holds the lock of every live tablet server.
+const ServerLockDir = "/bigtable/servers/"
+
+// ServerLockPath returns the lock a tablet server holds for as long as it lives.
+func ServerLockPath(serverID string) string {
+	return ServerLockDir + url.PathEscape(serverID)
+}
+
+// Campaign waits until it holds the master lock, then opens the master from
+// the METADATA in dir, like OpenMaster. Standby masters wait here until the
+// active master's session ends, which releases the lock.
+//
+// A master that loses its session stops serving, since a standby may have
+// taken over already; see Deposed.
+func Campaign(ctx context.Context, dir string, opts Options) (*Master, error) {
+	if opts.Lock == nil {
+		return nil, fmt.Errorf("campaigning needs a lock service")
+	}
+	session, err := lockservice.NewSession(opts.Lock)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open lock service session: %w", err)
+	}
+
+	fmt.Printf("Waiting for the master lock\n")
+	if err := session.AcquireWait(ctx, MasterLockPath, []byte(opts.Addr)); err != nil {
+		session.Close()
+		return nil, fmt.Errorf("failed to acquire the master lock: %w", err)
+	}
+	fmt.Printf("Acquired the master lock; becoming the active master\n")
+
+	m, err := OpenMaster(dir, opts)
+	if err != nil {
+		session.Close()
+		return nil, err
+	}
+	m.mu.Lock()
+	m.session = session
+	m.epoch = session.ID
+	m.mu.Unlock()
+	m.monitorWG.Add(1)
+	go m.watchSession()
+	return m, nil
+}
+
+// Deposed is closed once the master lost the master lock and stopped serving.
+// Its process should exit, or Campaign again.
+func (m *Master) Deposed() <-chan struct{} {
+	return m.deposed
+}
+
+// watchSession steps the master down when its session is lost, until Close.
+func (m *Master) watchSession() {
+	defer m.monitorWG.Done()
+	select {
+	case <-m.monitorStop:
+	case <-m.session.Done():
+		m.stepDown()
+	}
+}
+
+// stepDown stops the master from serving and closes the METADATA tablet, so
+// that the next active master can open it.
+func (m *Master) stepDown() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	fmt.Printf("Lost the master lock; stepping down\n")
+	close(m.deposed)
+	if m.metadata != nil {
+		if err := m.metadata.Close(); err != nil {
+			fmt.Printf("Failed to close METADATA: %v\n", err)
+		}
+		m.metadata = nil
+	}
+}
+
+// deposedLocked reports whether the master stepped down. It assumes the lock is held.
+func (m *Master) deposedLocked() bool {
+	select {
+	case <-m.deposed:
+		return true
+	default:
+		return false
+	}
+}
+
+// activeOnly wraps a handler to refuse requests once the master stepped down.
+func (m *Master) activeOnly(h http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-m.deposed:
+			http.Error(w, "not the active master", http.StatusServiceUnavailable)
+			return
+		default:
+		}
+		h(w, r)
+	}
+}
+
+// serverLockMonitor declares dead every registered server whose lock is gone,
+// re-reading the locks whenever they change, until Close.
+func (m *Master) serverLockMonitor() {
+	defer m.monitorWG.Done()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		<-m.monitorStop
+		cancel()
+	}()
+
+	for ctx.Err() == nil {
+		files, version, err := m.Options.Lock.List(ServerLockDir)
+		if err == nil {
+			m.ExpireUnlocked(files)
+			_, err = m.Options.Lock.Watch(ctx, ServerLockDir, version)
+		}
+		if err != nil && ctx.Err() == nil {
+			fmt.Printf("Failed to watch tablet server locks: %v\n", err)
+			select {
+			case <-ctx.Done():
+			case <-time.After(time.Second):
+			}
+		}
+	}
+}
+
+// ExpireUnlocked declares dead every server that holds none of the given
+// server locks, and reassigns its tablets. It returns the IDs of the dead servers.
+func (m *Master) ExpireUnlocked(locks []lockservice.File) []string {
+	locked := make(map[string]bool)
+	for _, f := range locks {
+		locked[f.Path] = true
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.deposedLocked() {
+		return nil
+	}
+	var dead []string
+	for id := range m.Servers {
+		if !locked[ServerLockPath(id)] {
+			dead = append(dead, id)
+		}
+	}
+	sort.Strings(dead)
+	m.declareDeadLocked(dead, "lost its lock")
+	return dead
+}
@@ -0,0 +1,184 @@
+package master
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Gourab-18/google_big_table/pkg/lockservice"
+)
+
+// partitionedLocker is a Locker whose keep-alives fail while cut off, as for
+// a master that can no longer reach the lock service.
+type partitionedLocker struct {
+	lockservice.Locker
+	cut atomic.Bool
+}
+
+func (l *partitionedLocker) KeepAlive(session string) error {
+	if l.cut.Load() {
+		return errors.New("network partition")
+	}
+	return l.Locker.KeepAlive(session)
+}
+
+func newTestLockService(t *testing.T, ttl time.Duration) *lockservice.Service {
+	t.Helper()
+	svc, err := lockservice.NewService(lockservice.Options{SessionTTL: ttl})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { svc.Close() })
+	return svc
+}
+
+// lockServer takes the server lock of id in a new session.
+func lockServer(t *testing.T, l lockservice.Locker, id string) *lockservice.Session {
+	t.Helper()
+	s, err := lockservice.NewSession(l)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := s.Acquire(ServerLockPath(id), []byte(id)); err != nil || !ok {
+		t.Fatalf("taking the server lock of %s = %v, %v", id, ok, err)
+	}
+	return s
+}
+
+func TestStandbyMasterTakesOver(t *testing.T) {
+	svc := newTestLockService(t, 300*time.Millisecond)
+	dir := t.TempDir()
+	l := &partitionedLocker{Locker: svc}
+	active, err := Campaign(context.Background(), dir, Options{Lock: l, Addr: "active"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer active.Close()
+	a := newFakeServer(t)
+	s := lockServer(t, svc, a.ID)
+	defer s.Close()
+	register(t, active, a.ID)
+	if _, err := active.CreateTable("t", []string{"m"}, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	campaigned := make(chan *Master, 1)
+	go func() {
+		m, err := Campaign(context.Background(), dir, Options{Lock: svc, Addr: "standby"})
+		if err != nil {
+			t.Error(err)
+		}
+		campaigned <- m
+	}()
+	select {
+	case <-campaigned:
+		t.Fatal("standby became active while the master lock is held")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// The active master is cut off from the lock service: it steps down
+	// before its session expires, and the standby takes over after.
+	l.cut.Store(true)
+	var standby *Master
+	select {
+	case standby = <-campaigned:
+	case <-time.After(5 * time.Second):
+		t.Fatal("standby did not take over")
+	}
+	if standby == nil {
+		t.FailNow()
+	}
+	defer standby.Close()
+	select {
+	case <-active.Deposed():
+	default:
+		t.Error("the standby took over before the active master stepped down")
+	}
+	if f, _ := svc.Get(MasterLockPath); f == nil || string(f.Contents) != "standby" || f.Session != standby.epoch {
+		t.Errorf("master lock = %+v, want the standby's address in the session of its epoch", f)
+	}
+
+	rec := httptest.NewRecorder()
+	active.activeOnly(active.HandleGetTablets)(rec, httptest.NewRequest(http.MethodGet, "/tablets", nil))
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Errorf("deposed master answered /tablets with %d, want 503", rec.Code)
+	}
+	// The new master picks up where the old one left off.
+	if info, err := standby.GetTable("t"); err != nil || info.Name != "t" {
+		t.Errorf("standby lost table t: %v", err)
+	}
+	if got := serverOf(standby, "root"); got != a.ID {
+		t.Errorf("standby has root on %q, want %s", got, a.ID)
+	}
+}
+
+func TestClosedMasterHandsOverRightAway(t *testing.T) {
+	svc := newTestLockService(t, time.Hour)
+	dir := t.TempDir()
+	active, err := Campaign(context.Background(), dir, Options{Lock: svc, Addr: "active"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	campaigned := make(chan *Master, 1)
+	go func() {
+		m, err := Campaign(context.Background(), dir, Options{Lock: svc, Addr: "standby"})
+		if err != nil {
+			t.Error(err)
+		}
+		campaigned <- m
+	}()
+
+	active.Close()
+	select {
+	case m := <-campaigned:
+		if m != nil {
+			m.Close()
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("standby did not take over from a closed master")
+	}
+}
+
+func TestRegisterNeedsServerLock(t *testing.T) {
+	svc := newTestLockService(t, time.Hour)
+	m := newTestMaster(t, Options{Lock: svc})
+	a := newFakeServer(t)
+
+	rec := httptest.NewRecorder()
+	m.HandleRegister(rec, httptest.NewRequest(http.MethodPost, "/register?id="+a.ID, nil))
+	if rec.Code != http.StatusConflict {
+		t.Errorf("registering without a server lock = %d, want 409", rec.Code)
+	}
+	s := lockServer(t, svc, a.ID)
+	defer s.Close()
+	register(t, m, a.ID)
+}
+
+func TestServerLosingLockIsDeclaredDead(t *testing.T) {
+	svc := newTestLockService(t, time.Hour)
+	m := newTestMaster(t, Options{Lock: svc})
+	a, b := newFakeServer(t), newFakeServer(t)
+	sa, sb := lockServer(t, svc, a.ID), lockServer(t, svc, b.ID)
+	defer sb.Close()
+	register(t, m, a.ID)
+	register(t, m, b.ID)
+
+	sa.Close()
+	deadline := time.Now().Add(5 * time.Second)
+	for serverOf(m, "root") != b.ID {
+		if time.Now().After(deadline) {
+			t.Fatalf("root is still on %q after its server lost its lock", serverOf(m, "root"))
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	m.mu.RLock()
+	_, alive := m.Servers[a.ID]
+	m.mu.RUnlock()
+	if alive {
+		t.Error("server without a lock is still registered")
+	}
+}
@@ -0,0 +1,303 @@
+package master
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Gourab-18/google_big_table/pkg/tablet"
+)
+
+// ServerInfo is what the master knows about a live tablet server.
+type ServerInfo struct {
+	ID            string
+	LastHeartbeat time.Time
+	// Tablets are the IDs of the tablets the server reported serving.
+	Tablets []string
+	// Load is the amount of data the server reported serving, in bytes.
+	Load int64
+}
+
+// HeartbeatRequest is sent periodically by every tablet server to renew its lease.
+type HeartbeatRequest struct {
+	ServerID string
+	Tablets  []string
+	Load     int64
+	// ColumnFamilies are the column families of every served tablet, by tablet ID.
+	ColumnFamilies map[string][]tablet.ColumnFamily `json:",omitempty"`
+}
+
+// HeartbeatResponse lists the tablets the master assigns to the server.
+// The server should serve exactly these.
+type HeartbeatResponse struct {
+	Tablets []Assignment
+	// Epoch identifies the master, as in EpochHeader.
+	Epoch string
+}
+
+// EpochHeader carries the epoch of the master on the load and unload commands
+// it sends to tablet servers. With a lock service, it is the session holding
+// the master lock; without, it identifies the master process. Servers refuse
+// commands from any master but the active one, so that a deposed master that
+// has not noticed yet cannot move tablets.
+const EpochHeader = "X-Master-Epoch"
+
+// Assignment is a tablet assigned to a server, with the column families of
+// its table, which the server applies to the tablet. Nil families, for tables
+// whose families are not known, leave the tablet's as they are.
+type Assignment struct {
+	TabletLocation
+	ColumnFamilies []tablet.ColumnFamily
+}
+
+// assignmentLocked returns the assignment of a tablet. It assumes the lock is held.
+func (m *Master) assignmentLocked(loc TabletLocation) Assignment {
+	a := Assignment{TabletLocation: loc}
+	if info := m.Tables[loc.Table]; info != nil {
+		a.ColumnFamilies = info.ColumnFamilies
+	}
+	return a
+}
+
+// HandleHeartbeat renews the lease of a registered server and records its
+// tablets and load. Tables whose column families are not known take those of
+// the first of their tablets reported. Servers the master does not know, e.g.
+// because their lease expired, get 404 and must register again.
+func (m *Master) HandleHeartbeat(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var hb HeartbeatRequest
+	if err := json.NewDecoder(r.Body).Decode(&hb); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	info, ok := m.Servers[hb.ServerID]
+	if !ok {
+		http.Error(w, fmt.Sprintf("unknown server %s", hb.ServerID), http.StatusNotFound)
+		return
+	}
+	info.LastHeartbeat = time.Now()
+	info.Tablets = hb.Tablets
+	info.Load = hb.Load
+	m.adoptColumnFamiliesLocked(hb)
+
+	resp := HeartbeatResponse{Tablets: []Assignment{}, Epoch: m.epoch}
+	for _, loc := range m.TabletLocations {
+		if loc.ServerID == hb.ServerID {
+			resp.Tablets = append(resp.Tablets, m.assignmentLocked(loc))
+		}
+	}
+	json.NewEncoder(w).Encode(resp)
+}
+
+// adoptColumnFamiliesLocked records the column families of the tablets a
+// server reports as those of their tables, for tables that have none recorded.
+// It assumes the lock is held.
+func (m *Master) adoptColumnFamiliesLocked(hb HeartbeatRequest) {
+	for _, loc := range m.TabletLocations {
+		families, ok := hb.ColumnFamilies[loc.TabletID]
+		info := m.Tables[loc.Table]
+		if !ok || loc.ServerID != hb.ServerID || info == nil || info.ColumnFamilies != nil {
+			continue
+		}
+		next := &TableInfo{Name: info.Name, ColumnFamilies: append([]tablet.ColumnFamily{}, families...)}
+		sort.Slice(next.ColumnFamilies, func(i, j int) bool { return next.ColumnFamilies[i].Name < next.ColumnFamilies[j].Name })
+		if err := m.persistTableLocked(next); err != nil {
+			fmt.Printf("Warning: %v\n", err)
+			continue
+		}
+		m.Tables[loc.Table] = next
+		fmt.Printf("Adopted %d column families of table %s from tablet %s\n", len(families), loc.Table, loc.TabletID)
+	}
+}
+
+// leaseMonitor expires the leases of silent servers until Close.
+func (m *Master) leaseMonitor() {
+	defer m.monitorWG.Done()
+	interval := min(m.Options.LeaseTimeout/4, time.Second)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-m.monitorStop:
+			return
+		case now := <-ticker.C:
+			m.ExpireLeases(now)
+		}
+	}
+}
+
+// ExpireLeases declares dead every server that has not heartbeated within
+// Options.LeaseTimeout of now, and reassigns its tablets to live servers.
+// It returns the IDs of the dead servers.
+func (m *Master) ExpireLeases(now time.Time) []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.deposedLocked() {
+		return nil
+	}
+	var dead []string
+	for id, info := range m.Servers {
+		if now.Sub(info.LastHeartbeat) > m.Options.LeaseTimeout {
+			dead = append(dead, id)
+		}
+	}
+	sort.Strings(dead)
+	m.declareDeadLocked(dead, "missed its lease")
+	return dead
+}
+
+// declareDeadLocked forgets the given servers and reassigns their tablets.
+// It assumes the lock is held.
+func (m *Master) declareDeadLocked(dead []string, reason string) {
+	for _, id := range dead {
+		fmt.Printf("Tablet server %s %s; declaring it dead\n", id, reason)
+		delete(m.Servers, id)
+	}
+	for _, id := range dead {
+		m.reassignLocked(id)
+	}
+}
+
+// reassignLocked moves the tablets of a dead server to the least loaded live
+// servers. With no live server left, they stay unassigned until one registers.
+// It assumes the lock is held.
+func (m *Master) reassignLocked(deadID string) {
+	assigned := m.assignedCountsLocked()
+
+	for i := range m.TabletLocations {
+		loc := &m.TabletLocations[i]
+		if loc.ServerID != deadID {
+			continue
+		}
+		target := m.leastLoadedLocked(assigned)
+		loc.ServerID = target
+		if err := m.persistLocked(*loc); err != nil {
+			fmt.Printf("Warning: %v\n", err)
+		}
+		if target == "" {
+			fmt.Printf("No live server for tablet %s; leaving it unassigned\n", loc.TabletID)
+			continue
+		}
+		assigned[target]++
+		fmt.Printf("Reassigned tablet %s from %s to %s\n", loc.TabletID, deadID, target)
+		m.sendLoadLocked(m.assignmentLocked(*loc))
+	}
+}
+
+// assignedCountsLocked counts the tablets of every live server, to balance
+// assignments. It assumes the lock is held.
+func (m *Master) assignedCountsLocked() map[string]int {
+	assigned := make(map[string]int)
+	for id := range m.Servers {
+		assigned[id] = 0
+	}
+	for _, loc := range m.TabletLocations {
+		if _, ok := assigned[loc.ServerID]; ok {
+			assigned[loc.ServerID]++
+		}
+	}
+	return assigned
+}
+
+// leastLoadedLocked picks the live server with the fewest tablets, then the
+// least data. It returns "" if there is none. It assumes the lock is held.
+func (m *Master) leastLoadedLocked(assigned map[string]int) string {
+	best := ""
+	for id := range assigned {
+		if best == "" {
+			best = id
+			continue
+		}
+		a, b := m.Servers[id], m.Servers[best]
+		switch {
+		case assigned[id] != assigned[best]:
+			if assigned[id] < assigned[best] {
+				best = id
+			}
+		case a.Load != b.Load:
+			if a.Load < b.Load {
+				best = id
+			}
+		case id < best:
+			best = id
+		}
+	}
+	return best
+}
+
+// commandClient sends commands to tablet servers.
+var commandClient = &http.Client{Timeout: 5 * time.Second}
+
+// sendLoadLocked tells the server of a tablet to load it, or to apply the
+// column families of the assignment if it serves it already, without waiting.
+// A server that misses the command still picks the tablet up from its next
+// heartbeat response. It assumes the lock is held.
+func (m *Master) sendLoadLocked(a Assignment) {
+	epoch := m.epoch
+	go func() {
+		body, err := json.Marshal(a)
+		if err != nil {
+			return
+		}
+		resp, err := sendCommand(serverURL(a.ServerID)+"/load", epoch, body)
+		if err != nil {
+			fmt.Printf("Failed to send load of %s to %s: %v\n", a.TabletID, a.ServerID, err)
+			return
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			fmt.Printf("Server %s failed to load %s: %s\n", a.ServerID, a.TabletID, resp.Status)
+		}
+	}()
+}
+
+// sendUnloadLocked tells the server of loc to stop serving the tablet, without
+// waiting. A server that misses the command still drops the tablet after its
+// next heartbeat. It assumes the lock is held.
+func (m *Master) sendUnloadLocked(loc TabletLocation) {
+	epoch := m.epoch
+	go func() {
+		u := serverURL(loc.ServerID) + "/unload?id=" + url.QueryEscape(loc.TabletID)
+		resp, err := sendCommand(u, epoch, nil)
+		if err != nil {
+			fmt.Printf("Failed to send unload of %s to %s: %v\n", loc.TabletID, loc.ServerID, err)
+			return
+		}
+		resp.Body.Close()
+	}()
+}
+
+// sendCommand posts a command to a tablet server on behalf of the master of epoch.
+func sendCommand(u, epoch string, body []byte) (*http.Response, error) {
+	req, err := http.NewRequest(http.MethodPost, u, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.Header.Set(EpochHeader, epoch)
+	return commandClient.Do(req)
+}
+
+// serverURL turns a server ID, its "host:port" address, into an HTTP URL.
+func serverURL(id string) string {
+	if strings.Contains(id, "://") {
+		return strings.TrimSuffix(id, "/")
+	}
+	return "http://" + id
+}
@@ -0,0 +1,256 @@
+package master
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// fakeServer is a tablet server that records the commands the master sends it.
+type fakeServer struct {
+	ID      string
+	loads   chan Assignment
+	unloads chan string
+	epoch   atomic.Value // EpochHeader of the last command
+}
+
+func newFakeServer(t *testing.T) *fakeServer {
+	t.Helper()
+	fs := &fakeServer{loads: make(chan Assignment, 64), unloads: make(chan string, 64)}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fs.epoch.Store(r.Header.Get(EpochHeader))
+		switch r.URL.Path {
+		case "/load":
+			var a Assignment
+			if err := json.NewDecoder(r.Body).Decode(&a); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			fs.loads <- a
+		case "/unload":
+			fs.unloads <- r.URL.Query().Get("id")
+		}
+	}))
+	t.Cleanup(srv.Close)
+	fs.ID = srv.URL
+	return fs
+}
+
+// nextLoad waits for the next load command the server receives.
+func (fs *fakeServer) nextLoad(t *testing.T) Assignment {
+	t.Helper()
+	select {
+	case a := <-fs.loads:
+		return a
+	case <-time.After(5 * time.Second):
+		t.Fatalf("%s received no load command", fs.ID)
+		return Assignment{}
+	}
+}
+
+func newTestMaster(t *testing.T, opts Options) *Master {
+	t.Helper()
+	m := NewMasterWithOptions(opts)
+	t.Cleanup(func() { m.Close() })
+	return m
+}
+
+func register(t *testing.T, m *Master, id string) {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	m.HandleRegister(rec, httptest.NewRequest(http.MethodPost, "/register?id="+url.QueryEscape(id), nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("registering %s = %d (%s)", id, rec.Code, rec.Body)
+	}
+}
+
+// heartbeat sends a heartbeat for id and returns the status and assigned tablet IDs.
+func heartbeat(t *testing.T, m *Master, id string) (int, []string) {
+	t.Helper()
+	body, _ := json.Marshal(HeartbeatRequest{ServerID: id})
+	rec := httptest.NewRecorder()
+	m.HandleHeartbeat(rec, httptest.NewRequest(http.MethodPost, "/heartbeat", bytes.NewReader(body)))
+	if rec.Code != http.StatusOK {
+		return rec.Code, nil
+	}
+	var resp HeartbeatResponse
+	if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+		t.Fatal(err)
+	}
+	if resp.Epoch != m.epoch {
+		t.Errorf("heartbeat response from epoch %q, want %q", resp.Epoch, m.epoch)
+	}
+	var ids []string
+	for _, a := range resp.Tablets {
+		ids = append(ids, a.TabletID)
+	}
+	sort.Strings(ids)
+	return rec.Code, ids
+}
+
+// serverOf returns the server of a tablet.
+func serverOf(m *Master, tabletID string) string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	for _, loc := range m.TabletLocations {
+		if loc.TabletID == tabletID {
+			return loc.ServerID
+		}
+	}
+	return ""
+}
+
+// age makes the last heartbeat of a server older by d.
+func age(m *Master, id string, d time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.Servers[id].LastHeartbeat = m.Servers[id].LastHeartbeat.Add(-d)
+}
+
+func TestFirstServerGetsRootTablet(t *testing.T) {
+	m := newTestMaster(t, DefaultOptions())
+	a := newFakeServer(t)
+	register(t, m, a.ID)
+
+	if load := a.nextLoad(t); load.TabletID != "root" || load.Table != DefaultTable {
+		t.Errorf("first load = %+v, want the root tablet of the default table", load)
+	}
+	if code, tablets := heartbeat(t, m, a.ID); code != http.StatusOK || len(tablets) != 1 || tablets[0] != "root" {
+		t.Errorf("heartbeat = %d %v, want the root tablet", code, tablets)
+	}
+	// The load carries the epoch of the heartbeat responses, so the server
+	// knows it is from the master it heartbeats to.
+	if got := a.epoch.Load(); got == "" || got != m.epoch {
+		t.Errorf("load came from epoch %q, want %q", got, m.epoch)
+	}
+}
+
+func TestHeartbeatRenewsLease(t *testing.T) {
+	m := newTestMaster(t, Options{LeaseTimeout: time.Minute})
+	a := newFakeServer(t)
+	register(t, m, a.ID)
+
+	age(m, a.ID, 2*time.Minute)
+	if code, _ := heartbeat(t, m, a.ID); code != http.StatusOK {
+		t.Fatalf("heartbeat = %d", code)
+	}
+	if dead := m.ExpireLeases(time.Now()); len(dead) != 0 {
+		t.Errorf("ExpireLeases declared %v dead right after a heartbeat", dead)
+	}
+	if dead := m.ExpireLeases(time.Now().Add(2 * time.Minute)); len(dead) != 1 || dead[0] != a.ID {
+		t.Errorf("ExpireLeases after the lease = %v, want %s", dead, a.ID)
+	}
+	if code, _ := heartbeat(t, m, a.ID); code != http.StatusNotFound {
+		t.Errorf("heartbeat of a dead server = %d, want 404", code)
+	}
+}
+
+func TestDeadServerTabletsAreReassigned(t *testing.T) {
+	m := newTestMaster(t, Options{LeaseTimeout: time.Minute})
+	a, b := newFakeServer(t), newFakeServer(t)
+	register(t, m, a.ID)
+	a.nextLoad(t)
+	register(t, m, b.ID)
+	if _, err := m.CreateTable("t", []string{"m"}, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	age(m, a.ID, 2*time.Minute)
+	if dead := m.ExpireLeases(time.Now()); len(dead) != 1 || dead[0] != a.ID {
+		t.Fatalf("ExpireLeases = %v, want %s", dead, a.ID)
+	}
+	m.mu.RLock()
+	for _, loc := range m.TabletLocations {
+		if loc.ServerID != b.ID {
+			t.Errorf("tablet %s is on %q, want the live server", loc.TabletID, loc.ServerID)
+		}
+	}
+	m.mu.RUnlock()
+
+	// The live server is told to load the root tablet, and gets it from its heartbeat.
+	deadline := time.After(5 * time.Second)
+	for found := false; !found; {
+		select {
+		case load := <-b.loads:
+			found = load.TabletID == "root"
+		case <-deadline:
+			t.Fatal("the live server was not told to load the root tablet")
+		}
+	}
+	if _, tablets := heartbeat(t, m, b.ID); len(tablets) != 3 {
+		t.Errorf("live server is assigned %v, want all 3 tablets", tablets)
+	}
+}
+
+func TestTabletsWaitForTheNextServer(t *testing.T) {
+	m := newTestMaster(t, Options{LeaseTimeout: time.Minute})
+	a := newFakeServer(t)
+	register(t, m, a.ID)
+	m.ExpireLeases(time.Now().Add(2 * time.Minute))
+	if got := serverOf(m, "root"); got != "" {
+		t.Fatalf("root is on %q with no live server, want unassigned", got)
+	}
+
+	c := newFakeServer(t)
+	register(t, m, c.ID)
+	if got := serverOf(m, "root"); got != c.ID {
+		t.Errorf("root is on %q, want the new server", got)
+	}
+	if load := c.nextLoad(t); load.TabletID != "root" {
+		t.Errorf("new server was told to load %q, want root", load.TabletID)
+	}
+}
+
+func TestReassignmentBalancesTablets(t *testing.T) {
+	m := newTestMaster(t, Options{LeaseTimeout: time.Minute})
+	a, b, x := newFakeServer(t), newFakeServer(t), newFakeServer(t)
+	m.mu.Lock()
+	now := time.Now()
+	m.Servers[a.ID] = &ServerInfo{ID: a.ID, LastHeartbeat: now, Load: 100}
+	m.Servers[b.ID] = &ServerInfo{ID: b.ID, LastHeartbeat: now, Load: 10}
+	m.Servers[x.ID] = &ServerInfo{ID: x.ID, LastHeartbeat: now.Add(-time.Hour)}
+	for i, server := range []string{a.ID, b.ID, b.ID, b.ID, x.ID, x.ID, x.ID, x.ID} {
+		m.TabletLocations = append(m.TabletLocations, TabletLocation{TabletID: string(rune('0' + i)), ServerID: server})
+	}
+	m.mu.Unlock()
+
+	m.ExpireLeases(now)
+	counts := make(map[string]int)
+	m.mu.RLock()
+	for _, loc := range m.TabletLocations {
+		counts[loc.ServerID]++
+	}
+	m.mu.RUnlock()
+	if counts[a.ID] != 4 || counts[b.ID] != 4 {
+		t.Errorf("tablets per server = %v, want 4 each", counts)
+	}
+}
+
+func TestLeaseMonitorExpiresSilentServers(t *testing.T) {
+	m := newTestMaster(t, Options{LeaseTimeout: 100 * time.Millisecond})
+	a := newFakeServer(t)
+	register(t, m, a.ID)
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		m.mu.RLock()
+		_, alive := m.Servers[a.ID]
+		m.mu.RUnlock()
+		if !alive {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("silent server is still alive")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if got := serverOf(m, "root"); got != "" {
+		t.Errorf("root is still on %q", got)
+	}
+}
@@ -1 +1,30 @@
 package master
+
+import (
+	"time"
+
+	"github.com/Gourab-18/google_big_table/pkg/lockservice"
+)
+
+// DefaultLeaseTimeout is the default Options.LeaseTimeout.
+const DefaultLeaseTimeout = 10 * time.Second
+
+// Options configures a Master.
+type Options struct {
+	// LeaseTimeout is how long a server stays alive without heartbeating.
+	// Once it expires, the server is declared dead and its tablets are reassigned.
+	LeaseTimeout time.Duration
+
+	// Lock is the lock service. With it, only the master holding the master
+	// lock is active (see Campaign), and a tablet server that loses its server
+	// lock is declared dead right away. Nil runs a single master.
+	Lock lockservice.Locker
+	// Addr is the address the master is reachable at. It is written into the
+	// master lock, where tablet servers look the active master up.
+	Addr string
+}
+
+// DefaultOptions returns the default master options.
+func DefaultOptions() Options {
+	return Options{LeaseTimeout: DefaultLeaseTimeout}
+}
@@ -0,0 +1,300 @@
+package master
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/Gourab-18/google_big_table/pkg/tablet"
+)
+
+// MetadataTabletID is the ID of the tablet holding the METADATA table.
+// Its location is the root location clients bootstrap from.
+const MetadataTabletID = "METADATA"
+
+// The METADATA table has one row per tablet, in family metadataFamily, and one
+// row per table, keyed by the bare table name, in family tableFamily.
+const (
+	metadataFamily = "location"
+	colTabletID    = "tablet_id"
+	colStartKey    = "start_key"
+	colServerID    = "server"
+
+	tableFamily  = "table"
+	colTableInfo = "info"
+)
+
+// MetadataRowKey returns the METADATA row of the tablet of table that ends at
+// endKey. Rows sort by table, then end key, with the last tablet of a table
+// (empty end key) after all others; so the tablet holding a row is the first
+// one whose METADATA key is greater than MetadataRowKey(table, row).
+func MetadataRowKey(table, endKey string) string {
+	if endKey == "" {
+		return table + "\x01"
+	}
+	return table + "\x00" + endKey
+}
+
+// MetadataTableRange returns the range [start, end) of the METADATA rows
+// holding the tablet locations of table.
+func MetadataTableRange(table string) (string, string) {
+	return table + "\x00", table + "\x02"
+}
+
+// LocationFromRow decodes a METADATA row into the location of its tablet.
+func LocationFromRow(row *tablet.Row) (TabletLocation, error) {
+	loc := TabletLocation{}
+	for qualifier, dst := range map[string]*string{
+		colTabletID: &loc.TabletID,
+		colStartKey: &loc.StartKey,
+		colServerID: &loc.ServerID,
+	} {
+		if v := row.Get(metadataFamily, qualifier); v != nil {
+			*dst = string(v.Value)
+		}
+	}
+	if loc.TabletID == "" {
+		return loc, fmt.Errorf("METADATA row %q has no tablet id", row.Key)
+	}
+
+	i := strings.IndexAny(row.Key, "\x00\x01")
+	switch {
+	case i < 0:
+		return loc, fmt.Errorf("malformed METADATA row key %q", row.Key)
+	case row.Key[i] == '\x01':
+		loc.Table, loc.EndKey = row.Key[:i], ""
+	default:
+		loc.Table, loc.EndKey = row.Key[:i], row.Key[i+1:]
+	}
+	return loc, nil
+}
+
+// tableFromRow decodes a METADATA table row. It returns nil for tablet rows.
+func tableFromRow(row *tablet.Row) (*TableInfo, error) {
+	v := row.Get(tableFamily, colTableInfo)
+	if v == nil {
+		return nil, nil
+	}
+	info := &TableInfo{}
+	if err := json.Unmarshal(v.Value, info); err != nil {
+		return nil, fmt.Errorf("malformed METADATA table row %q: %w", row.Key, err)
+	}
+	return info, nil
+}
+
+// openMetadata opens the METADATA tablet stored in dir, creating it if needed.
+func openMetadata(dir string) (*tablet.Tablet, error) {
+	t, err := tablet.NewTabletWithOptions("", "", filepath.Join(dir, MetadataTabletID), tablet.DefaultOptions())
+	if err != nil {
+		return nil, fmt.Errorf("failed to open METADATA tablet: %w", err)
+	}
+	for _, name := range []string{metadataFamily, tableFamily} {
+		if _, ok := t.Schema.Families[name]; ok {
+			continue
+		}
+		family := tablet.ColumnFamily{Name: name, GCRule: tablet.MaxVersionsGCRule(1)}
+		if err := t.SetColumnFamily(family); err != nil {
+			t.Close()
+			return nil, err
+		}
+	}
+	return t, nil
+}
+
+// loadMetadata reads every table and tablet location from the METADATA
+// tablet, in row order. Tables with tablets but no table row, written before
+// tables were recorded, get one whose column families are not known yet.
+func loadMetadata(meta *tablet.Tablet) (map[string]*TableInfo, []TabletLocation, error) {
+	it, err := meta.Scan("", "", tablet.ScanOptions{})
+	if err != nil {
+		return nil, nil, err
+	}
+	defer it.Close()
+
+	tables := make(map[string]*TableInfo)
+	locs := make([]TabletLocation, 0)
+	for it.Next() {
+		info, err := tableFromRow(it.Row())
+		if err != nil {
+			return nil, nil, err
+		}
+		if info != nil {
+			tables[info.Name] = info
+			continue
+		}
+		loc, err := LocationFromRow(it.Row())
+		if err != nil {
+			return nil, nil, err
+		}
+		if tables[loc.Table] == nil {
+			tables[loc.Table] = &TableInfo{Name: loc.Table}
+		}
+		locs = append(locs, loc)
+	}
+	if err := it.Err(); err != nil {
+		return nil, nil, err
+	}
+	return tables, locs, nil
+}
+
+// OpenMaster creates a Master whose tablet map is kept in a METADATA tablet in
+// dir, so it survives restarts. On reopening, the map is rebuilt from it, and
+// every server it names gets a fresh lease: live servers keep their tablets,
+// and the tablets of dead ones are reassigned once that lease expires.
+func OpenMaster(dir string, opts Options) (*Master, error) {
+	meta, err := openMetadata(dir)
+	if err != nil {
+		return nil, err
+	}
+	tables, locs, err := loadMetadata(meta)
+	if err != nil {
+		meta.Close()
+		return nil, fmt.Errorf("failed to load METADATA: %w", err)
+	}
+
+	m := NewMasterWithOptions(opts)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.metadata = meta
+	m.Tables = tables
+	m.TabletLocations = locs
+	for _, loc := range locs {
+		if loc.ServerID != "" && m.Servers[loc.ServerID] == nil {
+			m.Servers[loc.ServerID] = &ServerInfo{ID: loc.ServerID, LastHeartbeat: time.Now()}
+		}
+	}
+	fmt.Printf("Loaded %d tables and %d tablet locations from METADATA\n", len(tables), len(locs))
+	return m, nil
+}
+
+// persistLocked writes tablet locations to METADATA. It does nothing for a
+// master without one. It assumes the lock is held.
+func (m *Master) persistLocked(locs ...TabletLocation) error {
+	if m.metadata == nil {
+		return nil
+	}
+	for _, loc := range locs {
+		rm := tablet.NewRowMutation(MetadataRowKey(loc.Table, loc.EndKey))
+		rm.AddSet(metadataFamily, colTabletID, 0, []byte(loc.TabletID))
+		rm.AddSet(metadataFamily, colStartKey, 0, []byte(loc.StartKey))
+		rm.AddSet(metadataFamily, colServerID, 0, []byte(loc.ServerID))
+		if err := m.metadata.Mutate(rm); err != nil {
+			return fmt.Errorf("failed to persist location of %s: %w", loc.TabletID, err)
+		}
+	}
+	return nil
+}
+
+// unpersistLocked removes the METADATA row of a tablet location.
+// It assumes the lock is held.
+func (m *Master) unpersistLocked(loc TabletLocation) error {
+	if m.metadata == nil {
+		return nil
+	}
+	rm := tablet.NewRowMutation(MetadataRowKey(loc.Table, loc.EndKey))
+	rm.AddDeleteRow()
+	if err := m.metadata.Mutate(rm); err != nil {
+		return fmt.Errorf("failed to remove location of %s: %w", loc.TabletID, err)
+	}
+	return nil
+}
+
+// persistTableLocked writes a table row to METADATA. It assumes the lock is held.
+func (m *Master) persistTableLocked(info *TableInfo) error {
+	if m.metadata == nil {
+		return nil
+	}
+	data, err := json.Marshal(info)
+	if err != nil {
+		return err
+	}
+	rm := tablet.NewRowMutation(info.Name)
+	rm.AddSet(tableFamily, colTableInfo, 0, data)
+	if err := m.metadata.Mutate(rm); err != nil {
+		return fmt.Errorf("failed to persist table %s: %w", info.Name, err)
+	}
+	return nil
+}
+
+// unpersistTableLocked removes a table row from METADATA. It assumes the lock is held.
+func (m *Master) unpersistTableLocked(name string) error {
+	if m.metadata == nil {
+		return nil
+	}
+	rm := tablet.NewRowMutation(name)
+	rm.AddDeleteRow()
+	if err := m.metadata.Mutate(rm); err != nil {
+		return fmt.Errorf("failed to remove table %s: %w", name, err)
+	}
+	return nil
+}
+
+// HandleRoot returns the root location: where the METADATA tablet is served,
+// which is the master itself. Masters without METADATA respond 404, and
+// clients fall back to /tablets.
+func (m *Master) HandleRoot(w http.ResponseWriter, r *http.Request) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if m.metadata == nil {
+		http.Error(w, "no METADATA table", http.StatusNotFound)
+		return
+	}
+	// The address the client reached us at is the one it can reach us at.
+	json.NewEncoder(w).Encode(TabletLocation{TabletID: MetadataTabletID, ServerID: r.Host})
+}
+
+// HandleScan streams the METADATA rows with keys in [start, end) as
+// newline-delimited JSON, in the format of the tablet servers' /scan.
+// METADATA is small, so the rows are read under the lock, which keeps the
+// tablet open, and written after releasing it: a slow client must not hold up
+// registrations and heartbeats.
+func (m *Master) HandleScan(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	rows, err := m.scanMetadata(q.Get("start"), q.Get("end"))
+	if errors.Is(err, errNoMetadata) {
+		http.Error(w, "No tablet for key: no METADATA table", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	type scanLine struct {
+		Row *tablet.Row `json:",omitempty"`
+	}
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	enc := json.NewEncoder(w)
+	for _, row := range rows {
+		if err := enc.Encode(scanLine{Row: row}); err != nil {
+			return
+		}
+	}
+}
+
+// errNoMetadata is returned by scanMetadata on a master without METADATA.
+var errNoMetadata = errors.New("no METADATA table")
+
+// scanMetadata reads the METADATA rows with keys in [start, end).
+func (m *Master) scanMetadata(start, end string) ([]*tablet.Row, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if m.metadata == nil {
+		return nil, errNoMetadata
+	}
+	it, err := m.metadata.Scan(start, end, tablet.ScanOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var rows []*tablet.Row
+	for it.Next() {
+		rows = append(rows, it.Row())
+	}
+	return rows, it.Err()
+}
@@ -0,0 +1,153 @@
+package master
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+// blockingWriter is a client that reads nothing until released.
+type blockingWriter struct {
+	*httptest.ResponseRecorder
+	writing chan struct{}
+	release chan struct{}
+}
+
+func (w *blockingWriter) Write(p []byte) (int, error) {
+	select {
+	case w.writing <- struct{}{}:
+	default:
+	}
+	<-w.release
+	return w.ResponseRecorder.Write(p)
+}
+
+// scanKeys runs HandleScan and returns the keys of the rows.
+func scanKeys(t *testing.T, m *Master, query string) (int, []string) {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	m.HandleScan(rec, httptest.NewRequest(http.MethodGet, "/scan"+query, nil))
+	if rec.Code != http.StatusOK {
+		return rec.Code, nil
+	}
+	var keys []string
+	for sc := bufio.NewScanner(rec.Body); sc.Scan(); {
+		var line struct{ Row struct{ Key string } }
+		if err := json.Unmarshal(sc.Bytes(), &line); err != nil {
+			t.Fatal(err)
+		}
+		keys = append(keys, line.Row.Key)
+	}
+	return rec.Code, keys
+}
+
+func TestScanMetadata(t *testing.T) {
+	m, err := OpenMaster(t.TempDir(), DefaultOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer m.Close()
+	register(t, m, newFakeServer(t).ID)
+	if _, err := m.CreateTable("t", []string{"m"}, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	start, end := MetadataTableRange("t")
+	code, keys := scanKeys(t, m, "?"+url.Values{"start": {start}, "end": {end}}.Encode())
+	if code != http.StatusOK || len(keys) != 2 || keys[0] != MetadataRowKey("t", "m") || keys[1] != MetadataRowKey("t", "") {
+		t.Errorf("scan of t = %d %q, want its two tablet rows", code, keys)
+	}
+
+	if code, _ := scanKeys(t, newTestMaster(t, DefaultOptions()), ""); code != http.StatusNotFound {
+		t.Errorf("scan without METADATA = %d, want 404", code)
+	}
+}
+
+func TestSlowScanDoesNotBlockRegistration(t *testing.T) {
+	m, err := OpenMaster(t.TempDir(), DefaultOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer m.Close()
+	register(t, m, newFakeServer(t).ID)
+
+	w := &blockingWriter{ResponseRecorder: httptest.NewRecorder(), writing: make(chan struct{}), release: make(chan struct{})}
+	scanned := make(chan struct{})
+	go func() {
+		defer close(scanned)
+		m.HandleScan(w, httptest.NewRequest(http.MethodGet, "/scan", nil))
+	}()
+	defer func() {
+		close(w.release)
+		<-scanned
+	}()
+	select {
+	case <-w.writing:
+	case <-time.After(5 * time.Second):
+		t.Fatal("scan wrote nothing")
+	}
+
+	registered := make(chan struct{})
+	go func() {
+		defer close(registered)
+		register(t, m, newFakeServer(t).ID)
+	}()
+	select {
+	case <-registered:
+	case <-time.After(5 * time.Second):
+		t.Fatal("registration is blocked by a scan whose client does not read")
+	}
+}
+
+func TestOpenMasterReloadsMetadata(t *testing.T) {
+	dir := t.TempDir()
+	m, err := OpenMaster(dir, DefaultOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := newFakeServer(t)
+	register(t, m, a.ID)
+	if _, err := m.CreateTable("t", []string{"m"}, nil); err != nil {
+		t.Fatal(err)
+	}
+	want := m.ListTables()
+	m.mu.RLock()
+	wantLocs := append([]TabletLocation(nil), m.TabletLocations...)
+	m.mu.RUnlock()
+	m.Close()
+
+	m, err = OpenMaster(dir, DefaultOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer m.Close()
+	if got := m.ListTables(); !jsonEqual(t, got, want) {
+		t.Errorf("reloaded tables %+v, want %+v", got, want)
+	}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if !jsonEqual(t, m.TabletLocations, wantLocs) {
+		t.Errorf("reloaded locations %+v, want %+v", m.TabletLocations, wantLocs)
+	}
+	if m.Servers[a.ID] == nil {
+		t.Errorf("server %s named in METADATA has no lease", a.ID)
+	}
+}
+
+// jsonEqual reports whether a and b encode to the same JSON.
+func jsonEqual(t *testing.T, a, b any) bool {
+	t.Helper()
+	x, err := json.Marshal(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	y, err := json.Marshal(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(x) == string(y)
+}
@@ -4,11 +4,17 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
 	"sync"
+	"time"
+
+	"github.com/Gourab-18/google_big_table/pkg/lockservice"
+	"github.com/Gourab-18/google_big_table/pkg/tablet"
 )
 
 // TabletLocation represents where a tablet is currently served.
 type TabletLocation struct {
+	Table    string
 	TabletID string
 	StartKey string
 	EndKey   string
@@ -20,30 +26,99 @@ type Master struct {
 	mu sync.RWMutex
 
 	// Registry of live tablet servers
-	// map[ServerID]LastHeartbeat
-	Servers map[string]int64
+	// map[ServerID]*ServerInfo
+	Servers map[string]*ServerInfo
+
+	Options Options
+
+	// Tables maps table names to their schema.
+	Tables map[string]*TableInfo
 
 	// Tablet Assignment Metadata
 	// For simplicity, we keep a flat list or map.
-	// In Bigtable, this is the META0/META1 table.
-	// Here, in-memory map.
+	// In Bigtable, this is the META0/META1 table; masters opened with
+	// OpenMaster mirror the list into their METADATA tablet.
 	TabletLocations []TabletLocation
+
+	// metadata is the METADATA tablet. Nil keeps locations in memory only.
+	metadata *tablet.Tablet
+
+	// session holds the master lock of a master started by Campaign.
+	session *lockservice.Session
+	deposed chan struct{} // Closed when the master steps down
+
+	// epoch identifies this master to tablet servers: the ID of session, or of
+	// this incarnation for a master without one. It stamps every command sent
+	// to a server and every heartbeat response.
+	epoch string
+
+	monitorStop chan struct{}
+	monitorWG   sync.WaitGroup
 }
 
-// NewMaster creates a new Master instance.
+// NewMaster creates a new Master instance with default options.
 func NewMaster() *Master {
-	return &Master{
-		Servers:         make(map[string]int64),
+	return NewMasterWithOptions(DefaultOptions())
+}
+
+// NewMasterWithOptions creates a new Master instance.
+// It starts the lease monitor, and with a lock service the server lock
+// monitor, which run until Close.
+func NewMasterWithOptions(opts Options) *Master {
+	if opts.LeaseTimeout <= 0 {
+		opts.LeaseTimeout = DefaultLeaseTimeout
+	}
+	m := &Master{
+		Servers:         make(map[string]*ServerInfo),
+		Options:         opts,
+		Tables:          make(map[string]*TableInfo),
 		TabletLocations: make([]TabletLocation, 0),
+		epoch:           strconv.FormatInt(time.Now().UnixNano(), 36),
+		monitorStop:     make(chan struct{}),
+		deposed:         make(chan struct{}),
+	}
+	m.monitorWG.Add(1)
+	go m.leaseMonitor()
+	if opts.Lock != nil {
+		m.monitorWG.Add(1)
+		go m.serverLockMonitor()
 	}
+	return m
+}
+
+// Close stops the monitors, closes the METADATA tablet, if any, and gives up
+// the master lock, so that a standby master takes over right away.
+func (m *Master) Close() error {
+	close(m.monitorStop)
+	m.monitorWG.Wait()
+
+	m.mu.Lock()
+	var err error
+	if m.metadata != nil {
+		err = m.metadata.Close()
+		m.metadata = nil
+	}
+	m.mu.Unlock()
+
+	if m.session != nil {
+		if cerr := m.session.Close(); err == nil {
+			err = cerr
+		}
+	}
+	return err
 }
 
 // Serve starts the Master HTTP server.
 func (m *Master) Serve(addr string) error {
-	http.HandleFunc("/register", m.HandleRegister)
-	http.HandleFunc("/heartbeat", m.HandleHeartbeat)
-	http.HandleFunc("/tablets", m.HandleGetTablets)
-	http.HandleFunc("/split-report", m.HandleSplitReport)
+	http.HandleFunc("/register", m.activeOnly(m.HandleRegister))
+	http.HandleFunc("/heartbeat", m.activeOnly(m.HandleHeartbeat))
+	http.HandleFunc("/tablets", m.activeOnly(m.HandleGetTablets))
+	http.HandleFunc("/split-report", m.activeOnly(m.HandleSplitReport))
+	http.HandleFunc("/root", m.activeOnly(m.HandleRoot))
+	http.HandleFunc("/scan", m.activeOnly(m.HandleScan))
+	http.HandleFunc("/tables", m.activeOnly(m.HandleTables))
+	http.HandleFunc("/table", m.activeOnly(m.HandleTable))
+	http.HandleFunc("/table/families", m.activeOnly(m.HandleModifyColumnFamilies))
 
 	return http.ListenAndServe(addr, nil)
 }
@@ -55,35 +130,90 @@ func (m *Master) HandleRegister(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// With a lock service, a server lives as long as its lock: refuse servers
+	// that do not hold one, or they would be declared dead right away.
+	if m.Options.Lock != nil {
+		f, err := m.Options.Lock.Get(ServerLockPath(serverID))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		if f == nil {
+			http.Error(w, fmt.Sprintf("server %s holds no server lock", serverID), http.StatusConflict)
+			return
+		}
+	}
+
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	m.Servers[serverID] = 0 // Timestamp to be updated by heartbeat
+	// Registering grants the first lease; heartbeats renew it.
+	m.Servers[serverID] = &ServerInfo{ID: serverID, LastHeartbeat: time.Now()}
 	fmt.Printf("Registered new tablet server: %s\n", serverID)
 
-	// Initial Assignment: If this is the first server and we have no locations,
-	// assign the root tablet to it.
-	if len(m.TabletLocations) == 0 {
-		m.TabletLocations = append(m.TabletLocations, TabletLocation{
+	// Tablets left without a server when the last live one died go to the newcomer.
+	for i := range m.TabletLocations {
+		if m.TabletLocations[i].ServerID == "" {
+			m.TabletLocations[i].ServerID = serverID
+			if err := m.persistLocked(m.TabletLocations[i]); err != nil {
+				fmt.Printf("Warning: %v\n", err)
+			}
+			fmt.Printf("Assigned orphaned tablet %s to %s\n", m.TabletLocations[i].TabletID, serverID)
+			m.sendLoadLocked(m.assignmentLocked(m.TabletLocations[i]))
+		}
+	}
+
+	// Initial Assignment: If this is the first server and we have no tables,
+	// create the default table and assign its root tablet to it. The server
+	// may have served the tablet before the master kept METADATA, so its
+	// column families are taken from the tablet.
+	if len(m.Tables) == 0 {
+		info := &TableInfo{Name: DefaultTable}
+		root := TabletLocation{
+			Table:    DefaultTable,
 			TabletID: "root",
 			StartKey: "",
 			EndKey:   "",
 			ServerID: serverID,
-		})
+		}
+		if err := m.persistTableLocked(info); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		if err := m.persistLocked(root); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		m.Tables[DefaultTable] = info
+		m.TabletLocations = append(m.TabletLocations, root)
 		fmt.Printf("Assigned root tablet to %s\n", serverID)
+		m.sendLoadLocked(m.assignmentLocked(root))
 	}
 
 	w.WriteHeader(http.StatusOK)
 }
 
-func (m *Master) HandleHeartbeat(w http.ResponseWriter, r *http.Request) {
-	// TODO: Update timestamp
-}
-
+// HandleGetTablets lists the tablet locations of the table in the "table"
+// query parameter, the default table if none.
 func (m *Master) HandleGetTablets(w http.ResponseWriter, r *http.Request) {
+	table := r.URL.Query().Get("table")
+	if table == "" {
+		table = DefaultTable
+	}
+
 	m.mu.RLock()
 	defer m.mu.RUnlock()
-	json.NewEncoder(w).Encode(m.TabletLocations)
+	if m.Tables[table] == nil {
+		http.Error(w, fmt.Sprintf("%v: %s", ErrTableNotFound, table), http.StatusNotFound)
+		return
+	}
+	locs := make([]TabletLocation, 0)
+	for _, loc := range m.TabletLocations {
+		if loc.Table == table {
+			locs = append(locs, loc)
+		}
+	}
+	json.NewEncoder(w).Encode(locs)
 }
 
 func (m *Master) HandleSplitReport(w http.ResponseWriter, r *http.Request) {
@@ -107,22 +237,38 @@ func (m *Master) HandleSplitReport(w http.ResponseWriter, r *http.Request) {
 	// 1. Find and remove Parent
 	// Inefficient slice removal for prototype
 	newLocs := make([]TabletLocation, 0, len(m.TabletLocations)+1)
-	found := false
+	var parent *TabletLocation
 	for _, t := range m.TabletLocations {
 		if t.TabletID == split.ParentID {
-			found = true
+			parent = &t
 			continue // Skip (remove)
 		}
 		newLocs = append(newLocs, t)
 	}
 
-	if !found {
-		// Might have been processed or race condition
+	if parent == nil {
+		// Children of an unknown parent would belong to no table.
 		fmt.Printf("Warning: Split parent %s not found in metadata\n", split.ParentID)
+		http.Error(w, fmt.Sprintf("unknown split parent %q", split.ParentID), http.StatusNotFound)
+		return
 	}
+	// Children belong to the table of their parent.
+	split.Left.Table, split.Right.Table = parent.Table, parent.Table
 
 	// 2. Add children
-	// Assume the server reporting handles them for now
+	// Assume the server reporting handles them for now.
+	// The right child usually ends where the parent did and so replaces its
+	// METADATA row; writing the children first never leaves a key uncovered.
+	if err := m.persistLocked(split.Left, split.Right); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if parent.EndKey != split.Left.EndKey && parent.EndKey != split.Right.EndKey {
+		if err := m.unpersistLocked(*parent); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
 	newLocs = append(newLocs, split.Left, split.Right)
 
 	m.TabletLocations = newLocs
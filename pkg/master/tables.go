@@ -0,0 +1,347 @@
+package master
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/Gourab-18/google_big_table/pkg/tablet"
+)
+
+// DefaultTable is the table created when the first server registers with a
+// master that has no tables, and the table of requests that name none.
+const DefaultTable = "default"
+
+var (
+	ErrTableNotFound = errors.New("table not found")
+	ErrTableExists   = errors.New("table already exists")
+
+	// errInvalidTable is wrapped by the errors of invalid admin requests.
+	errInvalidTable = errors.New("invalid table")
+	// errFamiliesUnknown is returned when modifying the column families of a
+	// table before they are known.
+	errFamiliesUnknown = errors.New("column families not known yet")
+)
+
+// tableNamePattern is what Cloud Bigtable accepts as a table ID.
+var tableNamePattern = regexp.MustCompile(`^[_a-zA-Z0-9][-_.a-zA-Z0-9]*$`)
+
+// TableInfo describes a table.
+type TableInfo struct {
+	Name string
+	// ColumnFamilies are applied to every tablet of the table, sorted by name.
+	// They are nil for tables whose tablets may hold families the master never
+	// recorded: the tablets keep their own until the master adopts those of
+	// the first one served (see HandleHeartbeat).
+	ColumnFamilies []tablet.ColumnFamily
+}
+
+// ColumnFamilyModification creates, updates or drops one column family.
+// Exactly one of Create, Update and Drop should be set.
+type ColumnFamilyModification struct {
+	Name string
+	// Create declares a new family with this GC rule.
+	Create *tablet.GCRule `json:",omitempty"`
+	// Update replaces the GC rule of an existing family.
+	Update *tablet.GCRule `json:",omitempty"`
+	// Drop removes the family. Its data is no longer readable or writable.
+	Drop bool `json:",omitempty"`
+}
+
+// CreateTable creates a table with the given column families, split into one
+// tablet per range between consecutive split keys, and assigns the tablets to
+// the least loaded live servers.
+func (m *Master) CreateTable(name string, splitKeys []string, families []tablet.ColumnFamily) (*TableInfo, error) {
+	if !tableNamePattern.MatchString(name) || name == MetadataTabletID {
+		return nil, fmt.Errorf("%w: name %q must match %s", errInvalidTable, name, tableNamePattern)
+	}
+	info := &TableInfo{Name: name, ColumnFamilies: []tablet.ColumnFamily{}}
+	for _, f := range families {
+		mod := ColumnFamilyModification{Name: f.Name, Create: &f.GCRule}
+		if err := info.apply(mod); err != nil {
+			return nil, err
+		}
+	}
+
+	keys := append([]string(nil), splitKeys...)
+	sort.Strings(keys)
+	for i, k := range keys {
+		if k == "" || (i > 0 && k == keys[i-1]) {
+			return nil, fmt.Errorf("%w: split keys must be non-empty and distinct", errInvalidTable)
+		}
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.Tables[name] != nil {
+		return nil, fmt.Errorf("%w: %s", ErrTableExists, name)
+	}
+
+	// Tablet IDs name directories shared by all servers, so they must never
+	// come back, even if a table is deleted and created again.
+	prefix := fmt.Sprintf("%s-%x", name, time.Now().UnixNano())
+	assigned := m.assignedCountsLocked()
+	bounds := append(append([]string{""}, keys...), "")
+	locs := make([]TabletLocation, 0, len(keys)+1)
+	for i := 0; i+1 < len(bounds); i++ {
+		loc := TabletLocation{
+			Table:    name,
+			TabletID: fmt.Sprintf("%s-%d", prefix, i),
+			StartKey: bounds[i],
+			EndKey:   bounds[i+1],
+			ServerID: m.leastLoadedLocked(assigned),
+		}
+		if loc.ServerID != "" {
+			assigned[loc.ServerID]++
+		}
+		locs = append(locs, loc)
+	}
+
+	// The table row goes first: tablets without one would be adopted on reload.
+	if err := m.persistTableLocked(info); err != nil {
+		return nil, err
+	}
+	if err := m.persistLocked(locs...); err != nil {
+		return nil, err
+	}
+	m.Tables[name] = info
+	m.TabletLocations = append(m.TabletLocations, locs...)
+	fmt.Printf("Created table %s with %d tablets\n", name, len(locs))
+	for _, loc := range locs {
+		if loc.ServerID != "" {
+			m.sendLoadLocked(m.assignmentLocked(loc))
+		}
+	}
+	c := *info
+	return &c, nil
+}
+
+// DeleteTable deletes a table. Its servers stop serving its tablets; their
+// directories are left on disk.
+func (m *Master) DeleteTable(name string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.Tables[name] == nil {
+		return fmt.Errorf("%w: %s", ErrTableNotFound, name)
+	}
+
+	kept := make([]TabletLocation, 0, len(m.TabletLocations))
+	var dropped []TabletLocation
+	for _, loc := range m.TabletLocations {
+		if loc.Table == name {
+			dropped = append(dropped, loc)
+		} else {
+			kept = append(kept, loc)
+		}
+	}
+	for _, loc := range dropped {
+		if err := m.unpersistLocked(loc); err != nil {
+			return err
+		}
+	}
+	if err := m.unpersistTableLocked(name); err != nil {
+		return err
+	}
+
+	delete(m.Tables, name)
+	m.TabletLocations = kept
+	fmt.Printf("Deleted table %s with %d tablets\n", name, len(dropped))
+	for _, loc := range dropped {
+		if loc.ServerID != "" {
+			m.sendUnloadLocked(loc)
+		}
+	}
+	return nil
+}
+
+// ListTables returns every table, sorted by name.
+func (m *Master) ListTables() []TableInfo {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	tables := make([]TableInfo, 0, len(m.Tables))
+	for _, info := range m.Tables {
+		tables = append(tables, *info)
+	}
+	sort.Slice(tables, func(i, j int) bool { return tables[i].Name < tables[j].Name })
+	return tables
+}
+
+// GetTable returns a table.
+func (m *Master) GetTable(name string) (*TableInfo, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	info := m.Tables[name]
+	if info == nil {
+		return nil, fmt.Errorf("%w: %s", ErrTableNotFound, name)
+	}
+	c := *info
+	return &c, nil
+}
+
+// ModifyColumnFamilies applies modifications to the column families of a
+// table, all or none, and pushes the new families to the table's tablets.
+func (m *Master) ModifyColumnFamilies(name string, mods []ColumnFamilyModification) (*TableInfo, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	info := m.Tables[name]
+	if info == nil {
+		return nil, fmt.Errorf("%w: %s", ErrTableNotFound, name)
+	}
+	if info.ColumnFamilies == nil {
+		return nil, fmt.Errorf("%w: no tablet of %s was served yet", errFamiliesUnknown, name)
+	}
+	// TableInfos are replaced, never modified in place, as assignments share
+	// their families.
+	next := &TableInfo{Name: name, ColumnFamilies: append([]tablet.ColumnFamily{}, info.ColumnFamilies...)}
+	for _, mod := range mods {
+		if err := next.apply(mod); err != nil {
+			return nil, err
+		}
+	}
+	if err := m.persistTableLocked(next); err != nil {
+		return nil, err
+	}
+	m.Tables[name] = next
+
+	for _, loc := range m.TabletLocations {
+		if loc.Table == name && loc.ServerID != "" {
+			m.sendLoadLocked(m.assignmentLocked(loc))
+		}
+	}
+	c := *next
+	return &c, nil
+}
+
+// apply applies one modification to the column families of info.
+func (info *TableInfo) apply(mod ColumnFamilyModification) error {
+	set := 0
+	for _, ok := range []bool{mod.Create != nil, mod.Update != nil, mod.Drop} {
+		if ok {
+			set++
+		}
+	}
+	if set != 1 {
+		return fmt.Errorf("%w: modification of %q must create, update or drop the family", errInvalidTable, mod.Name)
+	}
+	if mod.Name == "" {
+		return fmt.Errorf("%w: column family name must not be empty", errInvalidTable)
+	}
+
+	i := sort.Search(len(info.ColumnFamilies), func(i int) bool { return info.ColumnFamilies[i].Name >= mod.Name })
+	exists := i < len(info.ColumnFamilies) && info.ColumnFamilies[i].Name == mod.Name
+	switch {
+	case mod.Create != nil:
+		if exists {
+			return fmt.Errorf("%w: column family %q already exists", errInvalidTable, mod.Name)
+		}
+		if err := mod.Create.Validate(); err != nil {
+			return fmt.Errorf("%w: %v", errInvalidTable, err)
+		}
+		family := tablet.ColumnFamily{Name: mod.Name, GCRule: *mod.Create}
+		info.ColumnFamilies = append(info.ColumnFamilies[:i], append([]tablet.ColumnFamily{family}, info.ColumnFamilies[i:]...)...)
+	case !exists:
+		return fmt.Errorf("%w %q", tablet.ErrUnknownColumnFamily, mod.Name)
+	case mod.Update != nil:
+		if err := mod.Update.Validate(); err != nil {
+			return fmt.Errorf("%w: %v", errInvalidTable, err)
+		}
+		info.ColumnFamilies[i].GCRule = *mod.Update
+	default:
+		info.ColumnFamilies = append(info.ColumnFamilies[:i], info.ColumnFamilies[i+1:]...)
+	}
+	return nil
+}
+
+// HandleTables lists the tables (GET) or creates one (POST). POST takes
+// {"Name", "SplitKeys", "ColumnFamilies"} and responds with the TableInfo.
+func (m *Master) HandleTables(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		json.NewEncoder(w).Encode(m.ListTables())
+	case http.MethodPost:
+		var req struct {
+			Name           string
+			SplitKeys      []string
+			ColumnFamilies []tablet.ColumnFamily
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		info, err := m.CreateTable(req.Name, req.SplitKeys, req.ColumnFamilies)
+		if err != nil {
+			writeTableError(w, err)
+			return
+		}
+		json.NewEncoder(w).Encode(info)
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// HandleTable returns (GET) or deletes (DELETE) the table in the "name" query parameter.
+func (m *Master) HandleTable(w http.ResponseWriter, r *http.Request) {
+	name := r.URL.Query().Get("name")
+	switch r.Method {
+	case http.MethodGet:
+		info, err := m.GetTable(name)
+		if err != nil {
+			writeTableError(w, err)
+			return
+		}
+		json.NewEncoder(w).Encode(info)
+	case http.MethodDelete:
+		if err := m.DeleteTable(name); err != nil {
+			writeTableError(w, err)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// HandleModifyColumnFamilies applies a list of ColumnFamilyModifications to the
+// table in the "name" query parameter and responds with the TableInfo.
+func (m *Master) HandleModifyColumnFamilies(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	var mods []ColumnFamilyModification
+	if err := json.NewDecoder(r.Body).Decode(&mods); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	info, err := m.ModifyColumnFamilies(r.URL.Query().Get("name"), mods)
+	if err != nil {
+		writeTableError(w, err)
+		return
+	}
+	json.NewEncoder(w).Encode(info)
+}
+
+// writeTableError responds with the status matching an admin error.
+func writeTableError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, ErrTableNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	case errors.Is(err, ErrTableExists):
+		http.Error(w, err.Error(), http.StatusConflict)
+	case errors.Is(err, tablet.ErrUnknownColumnFamily), errors.Is(err, errInvalidTable):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	case errors.Is(err, errFamiliesUnknown):
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+	default:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,450 @@
+package master
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Gourab-18/google_big_table/pkg/tablet"
+)
+
+// familyNames returns the names of families, in order.
+func familyNames(families []tablet.ColumnFamily) []string {
+	names := make([]string, 0, len(families))
+	for _, f := range families {
+		names = append(names, f.Name)
+	}
+	return names
+}
+
+// tableLocations returns the tablet locations of a table.
+func tableLocations(m *Master, table string) []TabletLocation {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	var locs []TabletLocation
+	for _, loc := range m.TabletLocations {
+		if loc.Table == table {
+			locs = append(locs, loc)
+		}
+	}
+	return locs
+}
+
+// sendHeartbeat sends hb and returns the status and the assignments.
+func sendHeartbeat(t *testing.T, m *Master, hb HeartbeatRequest) (int, []Assignment) {
+	t.Helper()
+	body, _ := json.Marshal(hb)
+	rec := httptest.NewRecorder()
+	m.HandleHeartbeat(rec, httptest.NewRequest(http.MethodPost, "/heartbeat", bytes.NewReader(body)))
+	if rec.Code != http.StatusOK {
+		return rec.Code, nil
+	}
+	var resp HeartbeatResponse
+	if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+		t.Fatal(err)
+	}
+	return rec.Code, resp.Tablets
+}
+
+func TestCreateTable(t *testing.T) {
+	m := newTestMaster(t, DefaultOptions())
+	a := newFakeServer(t)
+	register(t, m, a.ID)
+	a.nextLoad(t)
+
+	families := []tablet.ColumnFamily{{Name: "b", GCRule: tablet.MaxVersionsGCRule(1)}, {Name: "a"}}
+	info, err := m.CreateTable("t", []string{"m", "f"}, families)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := familyNames(info.ColumnFamilies); !reflect.DeepEqual(got, []string{"a", "b"}) {
+		t.Errorf("families = %v, want a and b sorted", got)
+	}
+
+	var ranges []string
+	for _, loc := range tableLocations(m, "t") {
+		ranges = append(ranges, loc.StartKey+"-"+loc.EndKey)
+		if loc.ServerID != a.ID {
+			t.Errorf("tablet %s is on %q, want the only server", loc.TabletID, loc.ServerID)
+		}
+	}
+	if !reflect.DeepEqual(ranges, []string{"-f", "f-m", "m-"}) {
+		t.Errorf("tablet ranges = %v, want split at f and m", ranges)
+	}
+	for range 3 {
+		if load := a.nextLoad(t); load.Table != "t" || !reflect.DeepEqual(load.ColumnFamilies, info.ColumnFamilies) {
+			t.Errorf("load = %+v, want a tablet of t with its families", load)
+		}
+	}
+
+	if _, err := m.CreateTable("t", nil, nil); !errors.Is(err, ErrTableExists) {
+		t.Errorf("creating t again = %v, want ErrTableExists", err)
+	}
+	for _, tt := range []struct {
+		name      string
+		table     string
+		splitKeys []string
+		families  []tablet.ColumnFamily
+	}{
+		{"bad name", "a/b", nil, nil},
+		{"METADATA", MetadataTabletID, nil, nil},
+		{"empty split key", "u", []string{""}, nil},
+		{"duplicate split key", "u", []string{"k", "k"}, nil},
+		{"duplicate family", "u", nil, []tablet.ColumnFamily{{Name: "a"}, {Name: "a"}}},
+		{"bad GC rule", "u", nil, []tablet.ColumnFamily{{Name: "a", GCRule: tablet.MaxVersionsGCRule(-1)}}},
+	} {
+		if _, err := m.CreateTable(tt.table, tt.splitKeys, tt.families); !errors.Is(err, errInvalidTable) {
+			t.Errorf("%s: CreateTable = %v, want errInvalidTable", tt.name, err)
+		}
+	}
+	if _, err := m.GetTable("u"); !errors.Is(err, ErrTableNotFound) {
+		t.Errorf("invalid table was created: %v", err)
+	}
+}
+
+func TestDeleteTable(t *testing.T) {
+	m := newTestMaster(t, DefaultOptions())
+	a := newFakeServer(t)
+	register(t, m, a.ID)
+	if _, err := m.CreateTable("t", []string{"m"}, nil); err != nil {
+		t.Fatal(err)
+	}
+	first := tableLocations(m, "t")
+
+	if err := m.DeleteTable("t"); err != nil {
+		t.Fatal(err)
+	}
+	if locs := tableLocations(m, "t"); len(locs) != 0 {
+		t.Errorf("deleted table still has tablets %v", locs)
+	}
+	unloaded := make(map[string]bool)
+	for range first {
+		select {
+		case id := <-a.unloads:
+			unloaded[id] = true
+		case <-time.After(5 * time.Second):
+			t.Fatal("server was not told to unload the tablets of the deleted table")
+		}
+	}
+	for _, loc := range first {
+		if !unloaded[loc.TabletID] {
+			t.Errorf("tablet %s was not unloaded", loc.TabletID)
+		}
+	}
+	if err := m.DeleteTable("t"); !errors.Is(err, ErrTableNotFound) {
+		t.Errorf("deleting t again = %v, want ErrTableNotFound", err)
+	}
+
+	// A new table of the same name never reuses the directories of the old one.
+	if _, err := m.CreateTable("t", []string{"m"}, nil); err != nil {
+		t.Fatal(err)
+	}
+	for _, loc := range tableLocations(m, "t") {
+		if unloaded[loc.TabletID] {
+			t.Errorf("recreated table reuses tablet ID %s", loc.TabletID)
+		}
+	}
+}
+
+func TestModifyColumnFamilies(t *testing.T) {
+	m := newTestMaster(t, DefaultOptions())
+	a := newFakeServer(t)
+	register(t, m, a.ID)
+	a.nextLoad(t)
+	if _, err := m.CreateTable("t", nil, []tablet.ColumnFamily{{Name: "a"}, {Name: "b"}}); err != nil {
+		t.Fatal(err)
+	}
+	a.nextLoad(t)
+
+	info, err := m.ModifyColumnFamilies("t", []ColumnFamilyModification{
+		{Name: "c", Create: &tablet.GCRule{MaxVersions: 2}},
+		{Name: "a", Update: &tablet.GCRule{MaxVersions: 1}},
+		{Name: "b", Drop: true},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []tablet.ColumnFamily{{Name: "a", GCRule: tablet.MaxVersionsGCRule(1)}, {Name: "c", GCRule: tablet.MaxVersionsGCRule(2)}}
+	if !reflect.DeepEqual(info.ColumnFamilies, want) {
+		t.Errorf("families = %+v, want %+v", info.ColumnFamilies, want)
+	}
+	if load := a.nextLoad(t); !reflect.DeepEqual(load.ColumnFamilies, want) {
+		t.Errorf("server was sent families %+v, want %+v", load.ColumnFamilies, want)
+	}
+
+	// Modifications apply all or none.
+	for _, tt := range []struct {
+		name string
+		mods []ColumnFamilyModification
+		want error
+	}{
+		{"unknown family", []ColumnFamilyModification{{Name: "d", Create: &tablet.GCRule{}}, {Name: "x", Drop: true}}, tablet.ErrUnknownColumnFamily},
+		{"existing family", []ColumnFamilyModification{{Name: "d", Create: &tablet.GCRule{}}, {Name: "a", Create: &tablet.GCRule{}}}, errInvalidTable},
+		{"no operation", []ColumnFamilyModification{{Name: "a"}}, errInvalidTable},
+		{"two operations", []ColumnFamilyModification{{Name: "a", Drop: true, Update: &tablet.GCRule{}}}, errInvalidTable},
+		{"bad GC rule", []ColumnFamilyModification{{Name: "a", Update: &tablet.GCRule{MaxAge: -1}}}, errInvalidTable},
+	} {
+		if _, err := m.ModifyColumnFamilies("t", tt.mods); !errors.Is(err, tt.want) {
+			t.Errorf("%s: ModifyColumnFamilies = %v, want %v", tt.name, err, tt.want)
+		}
+	}
+	if info, _ := m.GetTable("t"); !reflect.DeepEqual(info.ColumnFamilies, want) {
+		t.Errorf("failed modifications changed the families to %+v", info.ColumnFamilies)
+	}
+
+	// Dropping every family leaves the table with none, not with unknown ones.
+	info, err = m.ModifyColumnFamilies("t", []ColumnFamilyModification{{Name: "a", Drop: true}, {Name: "c", Drop: true}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info, err = m.ModifyColumnFamilies("t", nil); err != nil || info.ColumnFamilies == nil || len(info.ColumnFamilies) != 0 {
+		t.Errorf("families after dropping all = %#v, %v, want none", info, err)
+	}
+	if _, err := m.ModifyColumnFamilies("nope", nil); !errors.Is(err, ErrTableNotFound) {
+		t.Errorf("modifying a missing table = %v, want ErrTableNotFound", err)
+	}
+}
+
+func TestTableHandlers(t *testing.T) {
+	m := newTestMaster(t, DefaultOptions())
+	register(t, m, newFakeServer(t).ID)
+	call := func(h http.HandlerFunc, method, target, body string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		h(rec, httptest.NewRequest(method, target, strings.NewReader(body)))
+		return rec
+	}
+
+	rec := call(m.HandleTables, http.MethodPost, "/tables", `{"Name": "t", "SplitKeys": ["m"], "ColumnFamilies": [{"Name": "cf"}]}`)
+	var info TableInfo
+	if rec.Code != http.StatusOK || json.NewDecoder(rec.Body).Decode(&info) != nil || info.Name != "t" {
+		t.Fatalf("POST /tables = %d (%s)", rec.Code, rec.Body)
+	}
+	rec = call(m.HandleTables, http.MethodGet, "/tables", "")
+	var tables []TableInfo
+	if err := json.NewDecoder(rec.Body).Decode(&tables); err != nil {
+		t.Fatal(err)
+	}
+	if len(tables) != 2 || tables[0].Name != DefaultTable || tables[1].Name != "t" {
+		t.Errorf("GET /tables = %+v, want default and t", tables)
+	}
+
+	rec = call(m.HandleModifyColumnFamilies, http.MethodPost, "/table/families?name=t", `[{"Name": "cf2", "Create": {"MaxVersions": 1}}]`)
+	if rec.Code != http.StatusOK || json.NewDecoder(rec.Body).Decode(&info) != nil || len(info.ColumnFamilies) != 2 {
+		t.Errorf("POST /table/families = %d (%s)", rec.Code, rec.Body)
+	}
+	rec = call(m.HandleTable, http.MethodGet, "/table?name=t", "")
+	if rec.Code != http.StatusOK || json.NewDecoder(rec.Body).Decode(&info) != nil || !reflect.DeepEqual(familyNames(info.ColumnFamilies), []string{"cf", "cf2"}) {
+		t.Errorf("GET /table = %d (%s)", rec.Code, rec.Body)
+	}
+
+	for _, tt := range []struct {
+		name    string
+		handler http.HandlerFunc
+		method  string
+		target  string
+		body    string
+		want    int
+	}{
+		{"create existing", m.HandleTables, http.MethodPost, "/tables", `{"Name": "t"}`, http.StatusConflict},
+		{"create invalid", m.HandleTables, http.MethodPost, "/tables", `{"Name": "a b"}`, http.StatusBadRequest},
+		{"create malformed", m.HandleTables, http.MethodPost, "/tables", `{`, http.StatusBadRequest},
+		{"get missing", m.HandleTable, http.MethodGet, "/table?name=nope", "", http.StatusNotFound},
+		{"modify unknown family", m.HandleModifyColumnFamilies, http.MethodPost, "/table/families?name=t", `[{"Name": "x", "Drop": true}]`, http.StatusBadRequest},
+		{"modify missing", m.HandleModifyColumnFamilies, http.MethodPost, "/table/families?name=nope", `[]`, http.StatusNotFound},
+		{"modify with GET", m.HandleModifyColumnFamilies, http.MethodGet, "/table/families?name=t", "", http.StatusMethodNotAllowed},
+		{"tables with PUT", m.HandleTables, http.MethodPut, "/tables", "", http.StatusMethodNotAllowed},
+		{"delete", m.HandleTable, http.MethodDelete, "/table?name=t", "", http.StatusOK},
+		{"delete missing", m.HandleTable, http.MethodDelete, "/table?name=t", "", http.StatusNotFound},
+	} {
+		if rec := call(tt.handler, tt.method, tt.target, tt.body); rec.Code != tt.want {
+			t.Errorf("%s: status = %d (%s), want %d", tt.name, rec.Code, rec.Body, tt.want)
+		}
+	}
+}
+
+func TestTablesSurviveReload(t *testing.T) {
+	dir := t.TempDir()
+	m, err := OpenMaster(dir, DefaultOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+	register(t, m, newFakeServer(t).ID)
+	if _, err := m.CreateTable("gone", nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.CreateTable("t", []string{"m"}, []tablet.ColumnFamily{{Name: "cf"}}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.ModifyColumnFamilies("t", []ColumnFamilyModification{{Name: "cf2", Create: &tablet.GCRule{MaxVersions: 3}}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.DeleteTable("gone"); err != nil {
+		t.Fatal(err)
+	}
+	want := m.ListTables()
+	m.Close()
+
+	m, err = OpenMaster(dir, DefaultOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer m.Close()
+	if got := m.ListTables(); !reflect.DeepEqual(got, want) {
+		t.Errorf("reloaded tables %+v, want %+v", got, want)
+	}
+	if locs := tableLocations(m, "gone"); len(locs) != 0 {
+		t.Errorf("deleted table came back with tablets %v", locs)
+	}
+}
+
+// splitReport sends a split report and returns the status.
+func splitReport(m *Master, parent string, left, right TabletLocation) int {
+	body, _ := json.Marshal(map[string]any{"ParentID": parent, "Left": left, "Right": right})
+	rec := httptest.NewRecorder()
+	m.HandleSplitReport(rec, httptest.NewRequest(http.MethodPost, "/split-report", bytes.NewReader(body)))
+	return rec.Code
+}
+
+func TestSplitReport(t *testing.T) {
+	m, err := OpenMaster(t.TempDir(), DefaultOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer m.Close()
+	register(t, m, newFakeServer(t).ID)
+	if _, err := m.CreateTable("t", nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	parent := tableLocations(m, "t")[0]
+	start, end := MetadataTableRange("t")
+	scanT := "?" + url.Values{"start": {start}, "end": {end}}.Encode()
+
+	left := TabletLocation{TabletID: "left", EndKey: "m", ServerID: parent.ServerID}
+	right := TabletLocation{TabletID: "right", StartKey: "m", ServerID: parent.ServerID}
+	if code := splitReport(m, parent.TabletID, left, right); code != http.StatusOK {
+		t.Fatalf("split report = %d, want 200", code)
+	}
+	locs := tableLocations(m, "t")
+	if len(locs) != 2 || locs[0].TabletID != "left" || locs[1].TabletID != "right" {
+		t.Fatalf("tablets of t after the split = %+v, want left and right", locs)
+	}
+	_, want := scanKeys(t, m, scanT)
+	if !reflect.DeepEqual(want, []string{MetadataRowKey("t", "m"), MetadataRowKey("t", "")}) {
+		t.Errorf("METADATA rows of t = %q, want those of the children", want)
+	}
+
+	// A report for a parent the master does not know changes nothing.
+	n := len(m.TabletLocations)
+	left.TabletID, right.TabletID = "stray-left", "stray-right"
+	left.EndKey, right.StartKey = "x", "x"
+	if code := splitReport(m, "unknown", left, right); code != http.StatusNotFound {
+		t.Errorf("split report of an unknown parent = %d, want 404", code)
+	}
+	if got := len(m.TabletLocations); got != n {
+		t.Errorf("%d tablet locations after a stray report, want %d", got, n)
+	}
+	_, rows := scanKeys(t, m, "")
+	for _, row := range rows {
+		if row == MetadataRowKey("", "x") {
+			t.Errorf("a stray report persisted row %q", row)
+		}
+	}
+	if _, got := scanKeys(t, m, scanT); !reflect.DeepEqual(got, want) {
+		t.Errorf("METADATA rows of t = %q after a stray report, want %q", got, want)
+	}
+}
+
+func TestUpgradeKeepsColumnFamiliesOfTablets(t *testing.T) {
+	// METADATA written before tables were recorded: tablet rows only.
+	dir := t.TempDir()
+	meta, err := openMetadata(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := newFakeServer(t)
+	old := &Master{metadata: meta}
+	if err := old.persistLocked(
+		TabletLocation{Table: DefaultTable, TabletID: "root", EndKey: "m", ServerID: a.ID},
+		TabletLocation{Table: DefaultTable, TabletID: "right", StartKey: "m", ServerID: a.ID},
+	); err != nil {
+		t.Fatal(err)
+	}
+	if err := meta.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	m, err := OpenMaster(dir, DefaultOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+	info, err := m.GetTable(DefaultTable)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.ColumnFamilies != nil {
+		t.Errorf("families of a table without a table row = %+v, want unknown", info.ColumnFamilies)
+	}
+	if _, err := m.ModifyColumnFamilies(DefaultTable, []ColumnFamilyModification{{Name: "x", Create: &tablet.GCRule{}}}); !errors.Is(err, errFamiliesUnknown) {
+		t.Errorf("modifying unknown families = %v, want errFamiliesUnknown", err)
+	}
+
+	// Until the families are known, servers are told to leave them alone.
+	code, assigned := sendHeartbeat(t, m, HeartbeatRequest{ServerID: a.ID, Tablets: []string{}})
+	if code != http.StatusOK || len(assigned) != 2 {
+		t.Fatalf("heartbeat = %d %+v", code, assigned)
+	}
+	for _, as := range assigned {
+		if as.ColumnFamilies != nil {
+			t.Errorf("assignment of %s carries families %+v, want none", as.TabletID, as.ColumnFamilies)
+		}
+	}
+
+	// They are then taken from the tablets.
+	families := []tablet.ColumnFamily{{Name: "cf", GCRule: tablet.MaxVersionsGCRule(2)}, {Name: "old"}}
+	_, assigned = sendHeartbeat(t, m, HeartbeatRequest{
+		ServerID:       a.ID,
+		Tablets:        []string{"root", "right"},
+		ColumnFamilies: map[string][]tablet.ColumnFamily{"root": families, "right": families},
+	})
+	for _, as := range assigned {
+		if !reflect.DeepEqual(as.ColumnFamilies, families) {
+			t.Errorf("assignment of %s carries families %+v, want the tablet's %+v", as.TabletID, as.ColumnFamilies, families)
+		}
+	}
+	m.Close()
+
+	m, err = OpenMaster(dir, DefaultOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer m.Close()
+	if info, _ := m.GetTable(DefaultTable); !reflect.DeepEqual(info.ColumnFamilies, families) {
+		t.Errorf("reloaded families = %+v, want the adopted %+v", info.ColumnFamilies, families)
+	}
+}
+
+func TestFirstServerKeepsColumnFamiliesOfRootTablet(t *testing.T) {
+	m := newTestMaster(t, DefaultOptions())
+	a := newFakeServer(t)
+	register(t, m, a.ID)
+	if load := a.nextLoad(t); load.ColumnFamilies != nil {
+		t.Errorf("root tablet load carries families %+v, want none until known", load.ColumnFamilies)
+	}
+
+	// A fresh root tablet has no families: the table then has none either.
+	sendHeartbeat(t, m, HeartbeatRequest{ServerID: a.ID, Tablets: []string{"root"}, ColumnFamilies: map[string][]tablet.ColumnFamily{"root": {}}})
+	info, _ := m.GetTable(DefaultTable)
+	if info.ColumnFamilies == nil || len(info.ColumnFamilies) != 0 {
+		t.Errorf("families = %#v, want none", info.ColumnFamilies)
+	}
+	if _, err := m.ModifyColumnFamilies(DefaultTable, []ColumnFamilyModification{{Name: "cf", Create: &tablet.GCRule{}}}); err != nil {
+		t.Errorf("modifying the adopted families = %v", err)
+	}
+}
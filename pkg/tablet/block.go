@@ -0,0 +1,292 @@
+package tablet
+
+import (
+	"encoding/binary"
+	"errors"
+	"sort"
+)
+
+// errCorruptBlock is returned when a block or an encoded row cannot be decoded.
+var errCorruptBlock = errors.New("corrupt sstable block")
+
+// blockHandle locates a data block within an SSTable file.
+// LastKey is the largest row key stored in the block, which lets a reader
+// binary search the index for the only block that could hold a given key.
+type blockHandle struct {
+	LastKey string
+	Offset  uint64
+	Length  uint64
+}
+
+// blockBuilder accumulates sorted key/value entries into a single data block.
+// Each entry is encoded as: uvarint(len(key)) key uvarint(len(value)) value.
+type blockBuilder struct {
+	buf     []byte
+	lastKey string
+	entries int
+}
+
+// add appends an entry. Keys must be added in strictly increasing order.
+func (b *blockBuilder) add(key string, value []byte) {
+	b.buf = appendString(b.buf, key)
+	b.buf = appendBytes(b.buf, value)
+	b.lastKey = key
+	b.entries++
+}
+
+func (b *blockBuilder) size() int {
+	return len(b.buf)
+}
+
+func (b *blockBuilder) empty() bool {
+	return b.entries == 0
+}
+
+func (b *blockBuilder) reset() {
+	b.buf = b.buf[:0]
+	b.lastKey = ""
+	b.entries = 0
+}
+
+// iterateBlock calls fn for every entry in an encoded data block, in order.
+// Iteration stops early if fn returns false.
+func iterateBlock(data []byte, fn func(key string, value []byte) bool) error {
+	d := decoder{buf: data}
+	for len(d.buf) > 0 {
+		key := d.string()
+		value := d.bytes()
+		if d.err != nil {
+			return d.err
+		}
+		if !fn(key, value) {
+			return nil
+		}
+	}
+	return nil
+}
+
+// encodeIndex serializes the block index written at the end of an SSTable.
+func encodeIndex(index []blockHandle) []byte {
+	buf := binary.AppendUvarint(nil, uint64(len(index)))
+	for _, h := range index {
+		buf = appendString(buf, h.LastKey)
+		buf = binary.AppendUvarint(buf, h.Offset)
+		buf = binary.AppendUvarint(buf, h.Length)
+	}
+	return buf
+}
+
+// decodeIndex is the inverse of encodeIndex.
+func decodeIndex(data []byte) ([]blockHandle, error) {
+	d := decoder{buf: data}
+	n := d.uvarint()
+	index := make([]blockHandle, 0, n)
+	for i := uint64(0); i < n && d.err == nil; i++ {
+		index = append(index, blockHandle{
+			LastKey: d.string(),
+			Offset:  d.uvarint(),
+			Length:  d.uvarint(),
+		})
+	}
+	if d.err != nil {
+		return nil, d.err
+	}
+	return index, nil
+}
+
+// encodeRow serializes the columns of a row into the value of a block entry.
+// The row key itself is stored as the entry key.
+// Columns are written in sorted order so that the output is deterministic.
+//
+// Rows that carry tombstones have a trailing section after the columns:
+//
+//	varint(DeletedAt) uvarint(#families) {family varint(ts)}...
+//	uvarint(#columns) {family qualifier uvarint(#ranges) {varint(start) varint(end)}...}...
+//
+// Rows without tombstones omit it, so such rows encode as they always have.
+func encodeRow(r *Row) []byte {
+	colKeys := make([]string, 0, len(r.Columns))
+	for k := range r.Columns {
+		colKeys = append(colKeys, k)
+	}
+	sort.Strings(colKeys)
+
+	buf := binary.AppendUvarint(nil, uint64(len(colKeys)))
+	for _, k := range colKeys {
+		col := r.Columns[k]
+		buf = appendString(buf, col.Family)
+		buf = appendString(buf, col.Qualifier)
+		buf = binary.AppendUvarint(buf, uint64(len(col.Versions)))
+		for _, v := range col.Versions {
+			buf = binary.AppendVarint(buf, v.Timestamp)
+			buf = appendBytes(buf, v.Value)
+		}
+	}
+
+	if r.hasTombstones() {
+		buf = encodeTombstones(buf, r, colKeys)
+	}
+	return buf
+}
+
+// encodeTombstones appends the tombstone section of a row.
+func encodeTombstones(buf []byte, r *Row, colKeys []string) []byte {
+	buf = binary.AppendVarint(buf, r.DeletedAt)
+
+	families := make([]string, 0, len(r.FamilyDeletedAt))
+	for f := range r.FamilyDeletedAt {
+		families = append(families, f)
+	}
+	sort.Strings(families)
+	buf = binary.AppendUvarint(buf, uint64(len(families)))
+	for _, f := range families {
+		buf = appendString(buf, f)
+		buf = binary.AppendVarint(buf, r.FamilyDeletedAt[f])
+	}
+
+	var deleted []*Column
+	for _, k := range colKeys {
+		if col := r.Columns[k]; len(col.Tombstones) > 0 {
+			deleted = append(deleted, col)
+		}
+	}
+	buf = binary.AppendUvarint(buf, uint64(len(deleted)))
+	for _, col := range deleted {
+		buf = appendString(buf, col.Family)
+		buf = appendString(buf, col.Qualifier)
+		buf = binary.AppendUvarint(buf, uint64(len(col.Tombstones)))
+		for _, tr := range col.Tombstones {
+			buf = binary.AppendVarint(buf, tr.Start)
+			buf = binary.AppendVarint(buf, tr.End)
+		}
+	}
+	return buf
+}
+
+// decodeRow is the inverse of encodeRow.
+func decodeRow(key string, data []byte) (*Row, error) {
+	d := decoder{buf: data}
+	row := NewRow(key)
+	numCols := d.uvarint()
+	for i := uint64(0); i < numCols && d.err == nil; i++ {
+		col := NewColumn(d.string(), d.string())
+		numVersions := d.uvarint()
+		for j := uint64(0); j < numVersions && d.err == nil; j++ {
+			col.Versions = append(col.Versions, CellVersion{
+				Timestamp: d.varint(),
+				Value:     d.bytes(),
+			})
+		}
+		row.Columns[col.Family+":"+col.Qualifier] = col
+	}
+
+	if d.err == nil && len(d.buf) > 0 {
+		decodeTombstones(&d, row)
+	}
+	if d.err != nil {
+		return nil, d.err
+	}
+	return row, nil
+}
+
+// decodeTombstones is the inverse of encodeTombstones.
+func decodeTombstones(d *decoder, row *Row) {
+	row.DeletedAt = d.varint()
+
+	numFamilies := d.uvarint()
+	for i := uint64(0); i < numFamilies && d.err == nil; i++ {
+		family := d.string()
+		row.FamilyDeletedAt[family] = d.varint()
+	}
+
+	numCols := d.uvarint()
+	for i := uint64(0); i < numCols && d.err == nil; i++ {
+		family, qualifier := d.string(), d.string()
+		colKey := family + ":" + qualifier
+		col, ok := row.Columns[colKey]
+		if !ok {
+			col = NewColumn(family, qualifier)
+			row.Columns[colKey] = col
+		}
+		numRanges := d.uvarint()
+		for j := uint64(0); j < numRanges && d.err == nil; j++ {
+			col.Tombstones = append(col.Tombstones, TimestampRange{
+				Start: d.varint(),
+				End:   d.varint(),
+			})
+		}
+	}
+}
+
+func appendString(buf []byte, s string) []byte {
+	buf = binary.AppendUvarint(buf, uint64(len(s)))
+	return append(buf, s...)
+}
+
+func appendBytes(buf []byte, b []byte) []byte {
+	buf = binary.AppendUvarint(buf, uint64(len(b)))
+	return append(buf, b...)
+}
+
+// decoder reads the primitive encodings used by blocks.
+// The first failure is sticky: once err is set, every subsequent read returns a zero value.
+type decoder struct {
+	buf []byte
+	err error
+}
+
+func (d *decoder) uvarint() uint64 {
+	if d.err != nil {
+		return 0
+	}
+	v, n := binary.Uvarint(d.buf)
+	if n <= 0 {
+		d.err = errCorruptBlock
+		return 0
+	}
+	d.buf = d.buf[n:]
+	return v
+}
+
+func (d *decoder) varint() int64 {
+	if d.err != nil {
+		return 0
+	}
+	v, n := binary.Varint(d.buf)
+	if n <= 0 {
+		d.err = errCorruptBlock
+		return 0
+	}
+	d.buf = d.buf[n:]
+	return v
+}
+
+func (d *decoder) bytes() []byte {
+	n := d.uvarint()
+	if d.err != nil {
+		return nil
+	}
+	if n > uint64(len(d.buf)) {
+		d.err = errCorruptBlock
+		return nil
+	}
+	// Copy so that callers never alias the block buffer.
+	b := make([]byte, n)
+	copy(b, d.buf[:n])
+	d.buf = d.buf[n:]
+	return b
+}
+
+func (d *decoder) string() string {
+	n := d.uvarint()
+	if d.err != nil {
+		return ""
+	}
+	if n > uint64(len(d.buf)) {
+		d.err = errCorruptBlock
+		return ""
+	}
+	s := string(d.buf[:n])
+	d.buf = d.buf[n:]
+	return s
+}
@@ -0,0 +1,190 @@
+package tablet
+
+import (
+	"encoding/binary"
+	"hash/fnv"
+	"math"
+	"sort"
+)
+
+// DefaultBloomFalsePositiveRate is the false-positive rate of the row key filter
+// when none is configured.
+const DefaultBloomFalsePositiveRate = 0.01
+
+// BloomFilterOptions controls the Bloom filters stored in each SSTable.
+type BloomFilterOptions struct {
+	// FalsePositiveRate of the filter over row keys. Zero or negative disables it.
+	FalsePositiveRate float64
+
+	// Families enables an additional filter over row+column keys for each listed
+	// column family, built with the given false-positive rate.
+	// Point reads on these families can then skip files that hold the row but not the column.
+	Families map[string]float64
+}
+
+// BloomStats counts how often SSTable Bloom filters were consulted during point reads.
+type BloomStats struct {
+	// Hits is the number of checks where the filter reported the key may be present.
+	Hits int64
+	// Misses is the number of checks where the filter ruled the SSTable out.
+	Misses int64
+	// FalsePositives is the number of hits for which the SSTable did not hold the data.
+	FalsePositives int64
+}
+
+// BloomFilter is a probabilistic set of keys with no false negatives.
+type BloomFilter struct {
+	bits []byte
+	k    uint32
+}
+
+// newBloomFilter sizes a filter for n keys at the given false-positive rate
+// and adds the precomputed key hashes to it.
+func newBloomFilter(hashes []uint64, fpRate float64) *BloomFilter {
+	n := len(hashes)
+	if n == 0 {
+		n = 1
+	}
+	// Optimal sizing: m = -n*ln(p)/ln(2)^2, k = m/n*ln(2).
+	m := int(math.Ceil(-float64(n) * math.Log(fpRate) / (math.Ln2 * math.Ln2)))
+	if m < 64 {
+		m = 64
+	}
+	k := uint32(math.Round(float64(m) / float64(n) * math.Ln2))
+	if k < 1 {
+		k = 1
+	}
+	if k > 30 {
+		k = 30
+	}
+
+	b := &BloomFilter{bits: make([]byte, (m+7)/8), k: k}
+	for _, h := range hashes {
+		b.addHash(h)
+	}
+	return b
+}
+
+func bloomHash(key string) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte(key))
+	return h.Sum64()
+}
+
+// addHash sets the k bits for a key, using double hashing to derive the probes.
+func (b *BloomFilter) addHash(h uint64) {
+	nbits := uint64(len(b.bits) * 8)
+	h1, h2 := h&0xffffffff, h>>32
+	for i := uint64(0); i < uint64(b.k); i++ {
+		pos := (h1 + i*h2) % nbits
+		b.bits[pos/8] |= 1 << (pos % 8)
+	}
+}
+
+// MayContain reports whether key may be in the set.
+// A false result means the key was definitely never added.
+func (b *BloomFilter) MayContain(key string) bool {
+	nbits := uint64(len(b.bits) * 8)
+	if nbits == 0 {
+		return true
+	}
+	h := bloomHash(key)
+	h1, h2 := h&0xffffffff, h>>32
+	for i := uint64(0); i < uint64(b.k); i++ {
+		pos := (h1 + i*h2) % nbits
+		if b.bits[pos/8]&(1<<(pos%8)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// columnFilterKey is the key added to a per-family filter for a row and qualifier.
+func columnFilterKey(rowKey, qualifier string) string {
+	return rowKey + "\x00" + qualifier
+}
+
+// tombstoneFilterKey is added to a per-family filter when a row or family tombstone
+// covers the family, so that reads of any column still consult the file.
+func tombstoneFilterKey(rowKey string) string {
+	return rowKey + "\x00\x00"
+}
+
+// bloomBuilder collects key hashes while an SSTable is written
+// and builds the filters once the number of keys is known.
+type bloomBuilder struct {
+	opts     BloomFilterOptions
+	rows     []uint64
+	families map[string][]uint64
+}
+
+func newBloomBuilder(opts BloomFilterOptions) *bloomBuilder {
+	return &bloomBuilder{opts: opts, families: make(map[string][]uint64)}
+}
+
+func (b *bloomBuilder) add(row *Row) {
+	if b.opts.FalsePositiveRate > 0 {
+		b.rows = append(b.rows, bloomHash(row.Key))
+	}
+	for _, col := range row.Columns {
+		if _, ok := b.opts.Families[col.Family]; ok {
+			b.families[col.Family] = append(b.families[col.Family], bloomHash(columnFilterKey(row.Key, col.Qualifier)))
+		}
+	}
+	for family := range b.opts.Families {
+		if _, ok := row.FamilyDeletedAt[family]; ok || row.DeletedAt != 0 {
+			b.families[family] = append(b.families[family], bloomHash(tombstoneFilterKey(row.Key)))
+		}
+	}
+}
+
+// encode serializes all filters into the SSTable filter block.
+// Layout: uvarint(count) then, per filter, name, uvarint(k) and the bit array.
+// The row key filter has an empty name; per-family filters are named after the family.
+func (b *bloomBuilder) encode() []byte {
+	type named struct {
+		name   string
+		filter *BloomFilter
+	}
+	var filters []named
+	if b.opts.FalsePositiveRate > 0 {
+		filters = append(filters, named{"", newBloomFilter(b.rows, b.opts.FalsePositiveRate)})
+	}
+	families := make([]string, 0, len(b.opts.Families))
+	for f := range b.opts.Families {
+		families = append(families, f)
+	}
+	sort.Strings(families)
+	for _, f := range families {
+		rate := b.opts.Families[f]
+		if rate <= 0 {
+			continue
+		}
+		filters = append(filters, named{f, newBloomFilter(b.families[f], rate)})
+	}
+
+	buf := binary.AppendUvarint(nil, uint64(len(filters)))
+	for _, nf := range filters {
+		buf = appendString(buf, nf.name)
+		buf = binary.AppendUvarint(buf, uint64(nf.filter.k))
+		buf = appendBytes(buf, nf.filter.bits)
+	}
+	return buf
+}
+
+// decodeFilterBlock is the inverse of bloomBuilder.encode.
+func decodeFilterBlock(data []byte) (map[string]*BloomFilter, error) {
+	d := decoder{buf: data}
+	n := d.uvarint()
+	filters := make(map[string]*BloomFilter, n)
+	for i := uint64(0); i < n && d.err == nil; i++ {
+		name := d.string()
+		k := d.uvarint()
+		bits := d.bytes()
+		filters[name] = &BloomFilter{bits: bits, k: uint32(k)}
+	}
+	if d.err != nil {
+		return nil, d.err
+	}
+	return filters, nil
+}
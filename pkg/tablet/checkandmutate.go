@@ -0,0 +1,64 @@
+package tablet
+
+import "fmt"
+
+// CheckAndMutate atomically applies trueMutation to a row if predicate outputs
+// any cell of its current state, and falseMutation otherwise. It reports
+// whether the predicate matched.
+//
+// The predicate sees the row merged from the MemTables and all SSTables, and the
+// chosen branch is logged and applied before any other mutation of the tablet can
+// run, so no write can slip in between the check and the mutation.
+// A nil predicate matches if the row has any live cell. A nil branch writes nothing.
+// The branches must target rowKey.
+func (t *Tablet) CheckAndMutate(rowKey string, predicate *RowFilter, trueMutation, falseMutation *RowMutation) (bool, error) {
+	filter, err := compileRowFilter(predicate)
+	if err != nil {
+		return false, fmt.Errorf("invalid predicate: %w", err)
+	}
+	for _, m := range []*RowMutation{trueMutation, falseMutation} {
+		if m != nil && m.RowKey != rowKey {
+			return false, fmt.Errorf("mutation of row '%s' in check-and-mutate of row '%s'", m.RowKey, rowKey)
+		}
+	}
+
+	durability := t.Options.Durability
+
+	t.mu.Lock()
+	if t.closed {
+		t.mu.Unlock()
+		return false, ErrTabletClosed
+	}
+	if !t.InRange(rowKey) {
+		t.mu.Unlock()
+		return false, fmt.Errorf("%w: '%s' not in [%s, %s)", ErrKeyOutOfRange, rowKey, t.StartKey, t.EndKey)
+	}
+
+	// 1. Evaluate the predicate against the current row
+	current, err := t.mergeRowLocked(rowKey, func(r *SSTableReader) bool {
+		return r.MayContain(rowKey)
+	})
+	if err != nil {
+		t.mu.Unlock()
+		return false, err
+	}
+	matched := len(filter(rowFilterCells(current))) > 0
+
+	// 2. Log and apply the chosen branch
+	m := falseMutation
+	if matched {
+		m = trueMutation
+	}
+	if m == nil || len(m.Ops) == 0 {
+		t.mu.Unlock()
+		return matched, nil
+	}
+	seq, err := t.mutateLocked(m, durability)
+	t.mu.Unlock()
+	if err != nil {
+		return matched, err
+	}
+
+	// 3. Wait for durability
+	return matched, t.syncMutation(seq, durability)
+}
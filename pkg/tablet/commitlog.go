@@ -1,86 +1,554 @@
 package tablet
 
 import (
+	"bufio"
 	"encoding/gob"
+	"errors"
+	"fmt"
 	"io"
 	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
 	"sync"
+	"time"
 )
 
-// CommitLog manages the append-only log file for durability.
+const (
+	// walSegmentExt is the extension of commit log segments. A segment is named
+	// after the sequence number of its first record, e.g. 00000000000000000042.wal.
+	walSegmentExt = ".wal"
+	// walCheckpointFile holds the sequence number up to which the log has been flushed.
+	walCheckpointFile = "wal.checkpoint"
+	// legacyWALFile is the single, unsegmented log written by older versions.
+	legacyWALFile = "tablet.wal"
+)
+
+// Durability says when a mutation is acknowledged relative to the commit log.
+type Durability int
+
+const (
+	// DurabilitySync acknowledges a mutation once its log record is fsynced.
+	DurabilitySync Durability = iota
+	// DurabilityAsync acknowledges a mutation once it is logged; the fsync happens
+	// in the background. A crash can lose the latest acknowledged mutations.
+	DurabilityAsync
+	// DurabilityNone skips the commit log. The mutation only survives a crash
+	// once its MemTable has been flushed.
+	DurabilityNone
+)
+
+// CommitLogOptions configures how the commit log syncs to disk.
+type CommitLogOptions struct {
+	// GroupCommit makes one background goroutine fsync the records of all
+	// concurrent writers at once, instead of every writer paying its own fsync.
+	GroupCommit bool
+	// MaxBatchDelay is how long the group commit waits for more writers to join
+	// a batch before it fsyncs. Zero syncs as soon as someone is waiting.
+	MaxBatchDelay time.Duration
+	// MaxBatchSize ends the wait early once that many writers are waiting.
+	// Zero means no limit.
+	MaxBatchSize int
+
+	// StrictRecovery makes Recover fail with ErrCorruptCommitLog when a record is
+	// damaged anywhere but at the tail of the log. Otherwise the damaged part is
+	// logged and skipped. A torn tail, left by a crash during an append, is always
+	// discarded.
+	StrictRecovery bool
+}
+
+// LogRecord is a mutation as stored in the commit log, tagged with its sequence number.
+type LogRecord struct {
+	Seq      uint64
+	Mutation *RowMutation
+}
+
+// CommitLog manages the append-only log files for durability.
+//
+// The log is split into segments. A new segment is started whenever the tablet
+// freezes its MemTable (see Rotate), so once that MemTable is flushed, every
+// older segment only holds flushed mutations and can be deleted (see MarkFlushed).
+//
+// Append only buffers a record; Sync makes it durable. Syncs are done by a
+// background goroutine, which in group-commit mode batches the waiting writers
+// so that a single fsync covers all of them.
 type CommitLog struct {
 	mu   sync.Mutex
 	file *os.File
-	enc  *gob.Encoder
-	path string
+	w    *bufio.Writer
+	dir  string
+	opts CommitLogOptions
+
+	segments   []uint64 // First sequence number of every segment, ascending. The last one is active.
+	nextSeq    uint64
+	flushedSeq uint64
+	recovered  bool
+
+	// syncMu guards the sync state below; it is never held while taking mu.
+	syncMu    sync.Mutex
+	syncCond  *sync.Cond
+	syncedSeq uint64 // Every record up to syncedSeq is on disk
+	syncErr   error  // A failed fsync leaves the log in an unknown state, so it is sticky
+	waiters   int    // Writers blocked in Sync
+
+	syncWake chan struct{}
+	syncStop chan struct{}
+	syncWG   sync.WaitGroup
+
+	closeOnce sync.Once
+	closeErr  error
 }
 
-// NewCommitLog creates or opens an existing commit log.
-func NewCommitLog(path string) (*CommitLog, error) {
-	// Open file in append mode, create if not exists
-	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_RDWR, 0644)
+// NewCommitLog opens the commit log stored in dir.
+// Recover must be called before the first Append.
+func NewCommitLog(dir string, opts CommitLogOptions) (*CommitLog, error) {
+	l := &CommitLog{
+		dir:      dir,
+		opts:     opts,
+		syncWake: make(chan struct{}, 1),
+		syncStop: make(chan struct{}),
+	}
+	l.syncCond = sync.NewCond(&l.syncMu)
+
+	flushed, err := readWALCheckpoint(dir)
 	if err != nil {
 		return nil, err
 	}
+	l.flushedSeq = flushed
 
-	return &CommitLog{
-		file: f,
-		enc:  gob.NewEncoder(f),
-		path: path,
-	}, nil
+	if l.segments, err = listWALSegments(dir); err != nil {
+		return nil, err
+	}
+	if err := l.upgradeLegacyLog(); err != nil {
+		return nil, fmt.Errorf("failed to upgrade %s: %w", legacyWALFile, err)
+	}
+	return l, nil
 }
 
-// Append writes a mutation to the log.
-// It ensures durability by syncing to disk.
-func (l *CommitLog) Append(mutation *RowMutation) error {
-	l.mu.Lock()
-	defer l.mu.Unlock()
+// listWALSegments returns the first sequence numbers of the segments in dir, ascending.
+func listWALSegments(dir string) ([]uint64, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var segments []uint64
+	for _, e := range entries {
+		name := e.Name()
+		if filepath.Ext(name) != walSegmentExt {
+			continue
+		}
+		seq, err := strconv.ParseUint(strings.TrimSuffix(name, walSegmentExt), 10, 64)
+		if err != nil {
+			continue
+		}
+		segments = append(segments, seq)
+	}
+	sort.Slice(segments, func(i, j int) bool { return segments[i] < segments[j] })
+	return segments, nil
+}
 
-	// Encode and write to file
-	if err := l.enc.Encode(mutation); err != nil {
+func (l *CommitLog) segmentPath(firstSeq uint64) string {
+	return filepath.Join(l.dir, fmt.Sprintf("%020d%s", firstSeq, walSegmentExt))
+}
+
+// upgradeLegacyLog rewrites a pre-segmentation tablet.wal as the first segment.
+func (l *CommitLog) upgradeLegacyLog() error {
+	legacyPath := filepath.Join(l.dir, legacyWALFile)
+	f, err := os.Open(legacyPath)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
 		return err
 	}
+	defer f.Close()
+
+	var records []LogRecord
+	dec := gob.NewDecoder(f)
+	for {
+		var m RowMutation
+		if err := dec.Decode(&m); err == io.EOF {
+			break
+		} else if err != nil {
+			// Everything after a torn or corrupt mutation is lost either way.
+			if l.opts.StrictRecovery && !errors.Is(err, io.ErrUnexpectedEOF) {
+				return fmt.Errorf("%w: %s after %d records: %v", ErrCorruptCommitLog, legacyWALFile, len(records), err)
+			}
+			fmt.Printf("Discarding damaged tail of %s after %d records: %v\n", legacyPath, len(records), err)
+			break
+		}
+		records = append(records, LogRecord{Seq: uint64(len(records)) + 1, Mutation: &m})
+	}
 
-	// Ensure durability
-	return l.file.Sync()
+	if len(records) > 0 {
+		out, err := os.Create(l.segmentPath(1))
+		if err != nil {
+			return err
+		}
+		w := bufio.NewWriter(out)
+		w.WriteString(walSegmentMagic)
+		for i := range records {
+			frame, err := encodeWALRecord(&records[i])
+			if err != nil {
+				out.Close()
+				return err
+			}
+			w.Write(frame)
+		}
+		if err := w.Flush(); err != nil {
+			out.Close()
+			return err
+		}
+		if err := out.Sync(); err != nil {
+			out.Close()
+			return err
+		}
+		if err := out.Close(); err != nil {
+			return err
+		}
+		l.segments = append([]uint64{1}, l.segments...)
+	}
+	return os.Remove(legacyPath)
 }
 
-// Close closes the log file.
-func (l *CommitLog) Close() error {
+// openSegment starts a new active segment whose first record will be l.nextSeq.
+// A file left with that name can hold no complete record, so it is truncated.
+// It assumes the lock is held.
+func (l *CommitLog) openSegment() error {
+	f, err := os.OpenFile(l.segmentPath(l.nextSeq), os.O_TRUNC|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	if l.file != nil {
+		l.file.Close()
+	}
+	l.file = f
+	l.w = bufio.NewWriter(f)
+	if _, err := l.w.WriteString(walSegmentMagic); err != nil {
+		return err
+	}
+	if n := len(l.segments); n == 0 || l.segments[n-1] != l.nextSeq {
+		l.segments = append(l.segments, l.nextSeq)
+	}
+	return nil
+}
+
+// Append buffers a mutation in the log and returns its sequence number.
+// The record is not durable until Sync has returned for it.
+func (l *CommitLog) Append(mutation *RowMutation) (uint64, error) {
 	l.mu.Lock()
 	defer l.mu.Unlock()
-	return l.file.Close()
+
+	if !l.recovered {
+		return 0, fmt.Errorf("commit log must be recovered before appending")
+	}
+
+	// Frame and write into the segment's buffer
+	rec := LogRecord{Seq: l.nextSeq, Mutation: mutation}
+	frame, err := encodeWALRecord(&rec)
+	if err != nil {
+		return 0, err
+	}
+	if _, err := l.w.Write(frame); err != nil {
+		return 0, err
+	}
+	l.nextSeq++
+	return rec.Seq, nil
+}
+
+// Sync blocks until every record up to seq is on disk.
+// In group-commit mode the fsync is shared with the other writers waiting at the same time.
+func (l *CommitLog) Sync(seq uint64) error {
+	if !l.opts.GroupCommit {
+		l.syncMu.Lock()
+		done := l.syncedSeq >= seq
+		l.syncMu.Unlock()
+		if done {
+			return nil
+		}
+		return l.syncNow()
+	}
+
+	l.syncMu.Lock()
+	defer l.syncMu.Unlock()
+
+	l.waiters++
+	l.requestSync()
+	for l.syncedSeq < seq && l.syncErr == nil {
+		l.syncCond.Wait()
+	}
+	l.waiters--
+
+	if l.syncedSeq >= seq {
+		return nil
+	}
+	return l.syncErr
 }
 
-// Recover reads all mutations from the log file.
-// This is used to rebuild the MemTable on restart.
-func (l *CommitLog) Recover() ([]*RowMutation, error) {
+// SyncAsync asks the background goroutine to sync the log soon, without waiting for it.
+func (l *CommitLog) SyncAsync() {
+	l.requestSync()
+}
+
+func (l *CommitLog) requestSync() {
+	select {
+	case l.syncWake <- struct{}{}:
+	default:
+	}
+}
+
+// syncLoop is the single writer behind Sync and SyncAsync.
+func (l *CommitLog) syncLoop() {
+	defer l.syncWG.Done()
+
+	for {
+		select {
+		case <-l.syncStop:
+			return
+		case <-l.syncWake:
+		}
+		if l.opts.GroupCommit && l.opts.MaxBatchDelay > 0 {
+			l.awaitBatch()
+		}
+		if err := l.syncNow(); err != nil {
+			fmt.Printf("Failed to sync commit log in %s: %v\n", l.dir, err)
+		}
+	}
+}
+
+// awaitBatch lets more writers join the next fsync, until MaxBatchDelay has
+// passed or MaxBatchSize writers are waiting.
+func (l *CommitLog) awaitBatch() {
+	timer := time.NewTimer(l.opts.MaxBatchDelay)
+	defer timer.Stop()
+
+	for {
+		l.syncMu.Lock()
+		full := l.opts.MaxBatchSize > 0 && l.waiters >= l.opts.MaxBatchSize
+		l.syncMu.Unlock()
+		if full {
+			return
+		}
+
+		select {
+		case <-timer.C:
+			return
+		case <-l.syncStop:
+			return
+		case <-l.syncWake:
+		}
+	}
+}
+
+// syncNow writes out the buffered records and fsyncs the active segment,
+// then wakes the writers it covered.
+func (l *CommitLog) syncNow() error {
+	l.mu.Lock()
+	f, upTo := l.file, l.nextSeq-1
+	err := l.w.Flush()
+	l.mu.Unlock()
+
+	// The fsync runs without mu so that appends keep filling the next batch.
+	if err == nil {
+		err = f.Sync()
+		if errors.Is(err, os.ErrClosed) {
+			// Rotated in the meantime; Rotate synced the segment before closing it.
+			err = nil
+		}
+	}
+	l.markSynced(upTo, err)
+	return err
+}
+
+func (l *CommitLog) markSynced(upTo uint64, err error) {
+	l.syncMu.Lock()
+	defer l.syncMu.Unlock()
+
+	if err != nil {
+		if l.syncErr == nil {
+			l.syncErr = err
+		}
+	} else if upTo > l.syncedSeq {
+		l.syncedSeq = upTo
+	}
+	l.syncCond.Broadcast()
+}
+
+// Rotate closes the active segment and starts a new one, unless the active one is empty.
+// The closed segment is synced first, so its records are durable.
+func (l *CommitLog) Rotate() error {
 	l.mu.Lock()
 	defer l.mu.Unlock()
 
-	// Need to read from the beginning
-	// For simplicity, let's open a new reader interface to the same file path
-	// because seeking on the append-only writer handle might interact poorly with the encoder state.
-	f, err := os.Open(l.path)
+	if n := len(l.segments); n > 0 && l.segments[n-1] == l.nextSeq {
+		return nil // Active segment is still empty
+	}
+	err := l.w.Flush()
+	if err == nil {
+		err = l.file.Sync()
+	}
+	l.markSynced(l.nextSeq-1, err)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	defer f.Close()
+	return l.openSegment()
+}
 
-	var mutations []*RowMutation
-	dec := gob.NewDecoder(f)
+// FlushedSeq returns the sequence number up to which mutations are persisted in SSTables.
+func (l *CommitLog) FlushedSeq() uint64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.flushedSeq
+}
 
-	for {
-		var m RowMutation
-		err := dec.Decode(&m)
-		if err == io.EOF {
-			break
+// MarkFlushed records that every mutation up to seq is persisted in SSTables,
+// then deletes the segments that only hold such mutations.
+// The active segment is never deleted.
+func (l *CommitLog) MarkFlushed(seq uint64) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if seq <= l.flushedSeq {
+		return nil
+	}
+	if err := writeWALCheckpoint(l.dir, seq); err != nil {
+		return err
+	}
+	l.flushedSeq = seq
+
+	// Segment i ends right before segment i+1 starts.
+	kept := l.segments[:0]
+	for i, first := range l.segments {
+		if i < len(l.segments)-1 && l.segments[i+1] <= seq+1 {
+			if err := os.Remove(l.segmentPath(first)); err != nil && !os.IsNotExist(err) {
+				return err
+			}
+			continue
 		}
+		kept = append(kept, first)
+	}
+	l.segments = kept
+	return nil
+}
+
+// Close syncs whatever is buffered and closes the log file.
+// Calling it again returns the result of the first call.
+func (l *CommitLog) Close() error {
+	l.closeOnce.Do(func() { l.closeErr = l.close() })
+	return l.closeErr
+}
+
+func (l *CommitLog) close() error {
+	close(l.syncStop)
+	l.syncWG.Wait()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.file == nil {
+		return nil
+	}
+	err := l.w.Flush()
+	if err == nil {
+		err = l.file.Sync()
+	}
+	l.markSynced(l.nextSeq-1, err)
+	if cerr := l.file.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+// Recover reads the mutations that were logged after the last flush.
+// This is used to rebuild the MemTable on restart. Records at or below the
+// flushed sequence number are skipped: their data is already in SSTables.
+// Afterwards the log is positioned to append after the last record, in a new segment.
+func (l *CommitLog) Recover() ([]LogRecord, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var records []LogRecord
+	lastSeq := l.flushedSeq
+
+	for i, first := range l.segments {
+		// Skip segments that end at or before the checkpoint.
+		if i < len(l.segments)-1 && l.segments[i+1] <= l.flushedSeq+1 {
+			continue
+		}
+		path := l.segmentPath(first)
+		recs, goodEnd, damage, err := readWALSegment(path)
 		if err != nil {
 			return nil, err
 		}
-		mutations = append(mutations, &m)
+		for _, d := range damage {
+			if err := l.handleCorruption(path, i == len(l.segments)-1, goodEnd, d); err != nil {
+				return nil, err
+			}
+		}
+		for _, rec := range recs {
+			if rec.Seq > lastSeq {
+				lastSeq = rec.Seq
+			}
+			if rec.Seq > l.flushedSeq {
+				records = append(records, rec)
+			}
+		}
+	}
+
+	l.nextSeq = lastSeq + 1
+	if err := l.openSegment(); err != nil {
+		return nil, err
+	}
+	l.markSynced(lastSeq, nil)
+	l.recovered = true
+
+	l.syncWG.Add(1)
+	go l.syncLoop()
+	return records, nil
+}
+
+// handleCorruption decides what to do about damage to a segment found by Recover.
+// A torn tail of the last segment is cut off, so that the segment is clean once a
+// newer one follows it. Other damage fails recovery in strict mode; otherwise the
+// damaged record, or the rest of the segment if it cannot be stepped over, is skipped.
+func (l *CommitLog) handleCorruption(path string, last bool, goodEnd int64, damage walCorruption) error {
+	if last && damage.Tail {
+		fmt.Printf("Discarding torn tail of commit log segment %s at offset %d: %s\n", path, damage.Offset, damage.Reason)
+		return os.Truncate(path, goodEnd)
+	}
+	if l.opts.StrictRecovery {
+		return fmt.Errorf("%w: segment %s at offset %d: %s", ErrCorruptCommitLog, path, damage.Offset, damage.Reason)
+	}
+	if damage.Skipped {
+		fmt.Printf("Skipping corrupt record of commit log segment %s at offset %d: %s\n", path, damage.Offset, damage.Reason)
+		return nil
 	}
+	fmt.Printf("Skipping corrupt commit log segment %s from offset %d: %s\n", path, damage.Offset, damage.Reason)
+	return nil
+}
+
+// readWALCheckpoint returns the flushed sequence number stored in dir, or 0 if none.
+func readWALCheckpoint(dir string) (uint64, error) {
+	data, err := os.ReadFile(filepath.Join(dir, walCheckpointFile))
+	if os.IsNotExist(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	seq, err := strconv.ParseUint(strings.TrimSpace(string(data)), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %w", walCheckpointFile, err)
+	}
+	return seq, nil
+}
 
-	return mutations, nil
+// writeWALCheckpoint atomically replaces the flushed sequence number stored in dir.
+func writeWALCheckpoint(dir string, seq uint64) error {
+	path := filepath.Join(dir, walCheckpointFile)
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, []byte(strconv.FormatUint(seq, 10)+"\n"), 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
 }
@@ -0,0 +1,170 @@
+package tablet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"strings"
+)
+
+// Commit log segment format:
+//
+//	header:  "BTWAL01\n"
+//	record:  length (uint32 LE) | CRC32C of payload (uint32 LE) | payload
+//
+// The payload is a gob-encoded LogRecord with its own type information, so every
+// record can be decoded on its own. Segments written before records were framed
+// have no header and are a single gob stream; they are still readable.
+const (
+	walSegmentMagic     = "BTWAL01\n"
+	walRecordHeaderSize = 8
+	// maxWALRecordSize bounds the length field, so that a corrupt length is not
+	// mistaken for a huge record.
+	maxWALRecordSize = 256 << 20
+)
+
+// ErrCorruptCommitLog is returned by Recover in strict mode when a segment is
+// damaged anywhere but at the tail of the log.
+var ErrCorruptCommitLog = errors.New("corrupt commit log")
+
+var castagnoli = crc32.MakeTable(crc32.Castagnoli)
+
+// walCorruption describes a damaged record of a segment.
+type walCorruption struct {
+	Offset int64
+	Reason string
+	// Tail is set when nothing but zeros follows the damaged record, which is
+	// what an append interrupted by a crash leaves behind.
+	Tail bool
+	// Skipped is set when the record's length was intact, so reading went on
+	// with the record after it.
+	Skipped bool
+}
+
+// encodeWALRecord frames a record: length, checksum and payload.
+func encodeWALRecord(rec *LogRecord) ([]byte, error) {
+	var payload bytes.Buffer
+	if err := gob.NewEncoder(&payload).Encode(rec); err != nil {
+		return nil, err
+	}
+	if payload.Len() > maxWALRecordSize {
+		return nil, fmt.Errorf("commit log record of %d bytes exceeds %d", payload.Len(), maxWALRecordSize)
+	}
+
+	frame := make([]byte, walRecordHeaderSize, walRecordHeaderSize+payload.Len())
+	binary.LittleEndian.PutUint32(frame[0:4], uint32(payload.Len()))
+	binary.LittleEndian.PutUint32(frame[4:8], crc32.Checksum(payload.Bytes(), castagnoli))
+	return append(frame, payload.Bytes()...), nil
+}
+
+// decodeWALRecord decodes the framed record at the start of b and returns its
+// encoded size. On failure it returns the reason and how far the damaged
+// record extends, as far as it can tell: the whole frame when only the payload
+// is bad, the header when the length is invalid, and the rest of b when it is cut short.
+func decodeWALRecord(b []byte) (rec LogRecord, n int, reason string) {
+	if len(b) < walRecordHeaderSize {
+		return rec, len(b), "truncated record header"
+	}
+	length := binary.LittleEndian.Uint32(b[0:4])
+	if length == 0 || length > maxWALRecordSize {
+		return rec, walRecordHeaderSize, fmt.Sprintf("invalid record length %d", length)
+	}
+	n = walRecordHeaderSize + int(length)
+	if n > len(b) {
+		return rec, len(b), "truncated record"
+	}
+
+	payload := b[walRecordHeaderSize:n]
+	if crc32.Checksum(payload, castagnoli) != binary.LittleEndian.Uint32(b[4:8]) {
+		return rec, n, "checksum mismatch"
+	}
+	if err := gob.NewDecoder(bytes.NewReader(payload)).Decode(&rec); err != nil {
+		return rec, n, fmt.Sprintf("undecodable record: %v", err)
+	}
+	return rec, n, ""
+}
+
+// readWALSegment decodes the records of a segment file. A record whose payload
+// is damaged is skipped, as its length still says where the next one starts;
+// any other damage ends the segment. It returns the records, the offset right
+// after the last record read, and the damage found, in order. Only I/O errors
+// are returned as err.
+func readWALSegment(path string) ([]LogRecord, int64, []walCorruption, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+
+	if !bytes.HasPrefix(data, []byte(walSegmentMagic)) {
+		// A crash while writing the header leaves a prefix of it.
+		if strings.HasPrefix(walSegmentMagic, string(data)) {
+			if len(data) == 0 {
+				return nil, 0, nil, nil
+			}
+			return nil, 0, []walCorruption{{Reason: "truncated segment header", Tail: true}}, nil
+		}
+		return readLegacyWALSegment(data)
+	}
+
+	var records []LogRecord
+	var damage []walCorruption
+	off := len(walSegmentMagic)
+	for off < len(data) {
+		rec, n, reason := decodeWALRecord(data[off:])
+		if reason != "" {
+			c := walCorruption{
+				Offset: int64(off),
+				Reason: reason,
+				Tail:   allZero(data[off+n:]),
+			}
+			c.Skipped = !c.Tail && n > walRecordHeaderSize && off+n < len(data)
+			damage = append(damage, c)
+			if !c.Skipped {
+				return records, int64(off), damage, nil
+			}
+		} else {
+			records = append(records, rec)
+		}
+		off += n
+	}
+	return records, int64(off), damage, nil
+}
+
+// readLegacyWALSegment decodes a segment written before records were framed.
+// Without checksums, any decode failure counts as damage from that record on.
+func readLegacyWALSegment(data []byte) ([]LogRecord, int64, []walCorruption, error) {
+	var records []LogRecord
+	r := bytes.NewReader(data)
+	dec := gob.NewDecoder(r)
+
+	for {
+		good := int64(len(data) - r.Len())
+		var rec LogRecord
+		err := dec.Decode(&rec)
+		if err == io.EOF {
+			return records, good, nil, nil
+		}
+		if err != nil {
+			return records, good, []walCorruption{{
+				Offset: good,
+				Reason: fmt.Sprintf("undecodable record: %v", err),
+				Tail:   errors.Is(err, io.ErrUnexpectedEOF),
+			}}, nil
+		}
+		records = append(records, rec)
+	}
+}
+
+func allZero(b []byte) bool {
+	for _, c := range b {
+		if c != 0 {
+			return false
+		}
+	}
+	return true
+}
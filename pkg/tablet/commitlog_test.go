@@ -0,0 +1,467 @@
+package tablet
+
+import (
+	"encoding/binary"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+// recoverTestLog opens and recovers the commit log in dir.
+func recoverTestLog(t *testing.T, dir string, opts CommitLogOptions) (*CommitLog, []LogRecord) {
+	t.Helper()
+	l, err := NewCommitLog(dir, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	records, err := l.Recover()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return l, records
+}
+
+// appendTestRecords appends a mutation of row k for every value and syncs them.
+func appendTestRecords(t *testing.T, l *CommitLog, values ...string) {
+	t.Helper()
+	var seq uint64
+	for _, v := range values {
+		var err error
+		if seq, err = l.Append(set("k", "q", 1, v)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := l.Sync(seq); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func recordSeqs(records []LogRecord) []uint64 {
+	var seqs []uint64
+	for _, rec := range records {
+		seqs = append(seqs, rec.Seq)
+	}
+	return seqs
+}
+
+func TestCommitLogRotateAndMarkFlushed(t *testing.T) {
+	dir := t.TempDir()
+	l, records := recoverTestLog(t, dir, CommitLogOptions{})
+	if len(records) != 0 {
+		t.Fatalf("new log recovered %d records", len(records))
+	}
+	appendTestRecords(t, l, "a", "b", "c")
+	if err := l.Rotate(); err != nil {
+		t.Fatal(err)
+	}
+	appendTestRecords(t, l, "d", "e")
+
+	segments, err := listWALSegments(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []uint64{1, 4}; !reflect.DeepEqual(segments, want) {
+		t.Fatalf("segments = %v, want %v", segments, want)
+	}
+
+	// The first segment still holds an unflushed record.
+	if err := l.MarkFlushed(2); err != nil {
+		t.Fatal(err)
+	}
+	if segments, _ = listWALSegments(dir); len(segments) != 2 {
+		t.Fatalf("segments = %v after a partial flush, want both", segments)
+	}
+	if err := l.MarkFlushed(3); err != nil {
+		t.Fatal(err)
+	}
+	if segments, _ = listWALSegments(dir); !reflect.DeepEqual(segments, []uint64{4}) {
+		t.Fatalf("segments = %v after flushing the first one, want [4]", segments)
+	}
+	if err := l.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.Close(); err != nil {
+		t.Errorf("second Close = %v", err)
+	}
+
+	l, records = recoverTestLog(t, dir, CommitLogOptions{})
+	defer l.Close()
+	if got := recordSeqs(records); !reflect.DeepEqual(got, []uint64{4, 5}) {
+		t.Errorf("recovered %v, want [4 5]", got)
+	}
+	if got := l.FlushedSeq(); got != 3 {
+		t.Errorf("FlushedSeq = %d, want 3", got)
+	}
+	seq, err := l.Append(set("k", "q", 1, "f"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if seq != 6 {
+		t.Errorf("Append after recovery = %d, want 6", seq)
+	}
+}
+
+func TestRotateKeepsEmptyActiveSegment(t *testing.T) {
+	dir := t.TempDir()
+	l, _ := recoverTestLog(t, dir, CommitLogOptions{})
+	defer l.Close()
+	appendTestRecords(t, l, "a")
+	for i := 0; i < 2; i++ {
+		if err := l.Rotate(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if segments, _ := listWALSegments(dir); !reflect.DeepEqual(segments, []uint64{1, 2}) {
+		t.Errorf("segments = %v, want [1 2]", segments)
+	}
+}
+
+func TestFlushTruncatesCommitLog(t *testing.T) {
+	tb := openTestTablet(t, t.TempDir(), testOptions())
+	mustMutate(t, tb, set("a", "q", 1, "a1"))
+	mustMutate(t, tb, set("b", "q", 1, "b1"))
+	flushTestTablet(t, tb)
+	if segments, _ := listWALSegments(tb.Dir); len(segments) != 1 {
+		t.Errorf("segments = %v after a flush, want only the active one", segments)
+	}
+	mustMutate(t, tb, set("c", "q", 1, "c1"))
+
+	tb = reopenTestTablet(t, tb)
+	// Only the mutation after the flush is replayed.
+	if tb.MemTable.Get("a") != nil || tb.MemTable.Get("b") != nil {
+		t.Error("flushed mutations were replayed into the MemTable")
+	}
+	if tb.MemTable.Get("c") == nil {
+		t.Error("unflushed mutation was not replayed")
+	}
+	for key, want := range map[string]string{"a": "a1", "b": "b1", "c": "c1"} {
+		if got := readValue(t, tb, key, "q"); got != want {
+			t.Errorf("%s = %q, want %q", key, got, want)
+		}
+	}
+}
+
+func TestLegacyCommitLogIsUpgraded(t *testing.T) {
+	dir := t.TempDir()
+	f, err := os.Create(filepath.Join(dir, legacyWALFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	enc := gob.NewEncoder(f)
+	for _, m := range []*RowMutation{set("a", "q", 1, "a1"), set("b", "q", 1, "b1")} {
+		if err := enc.Encode(m); err != nil {
+			t.Fatal(err)
+		}
+	}
+	f.Close()
+
+	l, records := recoverTestLog(t, dir, CommitLogOptions{})
+	defer l.Close()
+	if got := recordSeqs(records); !reflect.DeepEqual(got, []uint64{1, 2}) {
+		t.Fatalf("recovered %v, want [1 2]", got)
+	}
+	if records[1].Mutation.RowKey != "b" {
+		t.Errorf("second record is for %q, want b", records[1].Mutation.RowKey)
+	}
+	if _, err := os.Stat(filepath.Join(dir, legacyWALFile)); !os.IsNotExist(err) {
+		t.Errorf("%s was not removed: %v", legacyWALFile, err)
+	}
+}
+
+func TestGroupCommitConcurrentWriters(t *testing.T) {
+	opts := testOptions()
+	opts.CommitLog.GroupCommit = true
+	tb := openTestTablet(t, t.TempDir(), opts)
+
+	const writers = 50
+	var wg sync.WaitGroup
+	errs := make(chan error, writers)
+	for i := 0; i < writers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs <- tb.Mutate(set(fmt.Sprintf("row%02d", i), "q", 1, fmt.Sprint(i)))
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	tb.CommitLog.syncMu.Lock()
+	synced := tb.CommitLog.syncedSeq
+	tb.CommitLog.syncMu.Unlock()
+	if synced != writers {
+		t.Errorf("syncedSeq = %d after every writer returned, want %d", synced, writers)
+	}
+
+	tb = reopenTestTablet(t, tb)
+	if n := len(scanValues(t, tb)); n != writers {
+		t.Errorf("recovered %d rows, want %d", n, writers)
+	}
+}
+
+func TestGroupCommitFullBatchSyncsWithoutDelay(t *testing.T) {
+	const batch = 4
+	l, _ := recoverTestLog(t, t.TempDir(), CommitLogOptions{
+		GroupCommit:   true,
+		MaxBatchDelay: time.Hour,
+		MaxBatchSize:  batch,
+	})
+	defer l.Close()
+
+	done := make(chan error, batch)
+	for i := 0; i < batch; i++ {
+		seq, err := l.Append(set("k", "q", 1, fmt.Sprint(i)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		go func() { done <- l.Sync(seq) }()
+	}
+	for i := 0; i < batch; i++ {
+		select {
+		case err := <-done:
+			if err != nil {
+				t.Fatal(err)
+			}
+		case <-time.After(10 * time.Second):
+			t.Fatal("a full batch waited for MaxBatchDelay")
+		}
+	}
+}
+
+func TestDurability(t *testing.T) {
+	tests := []struct {
+		durability Durability
+		logged     bool
+	}{
+		{DurabilitySync, true},
+		{DurabilityAsync, true},
+		{DurabilityNone, false},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.durability), func(t *testing.T) {
+			tb := openTestTablet(t, t.TempDir(), testOptions())
+			err := tb.MutateWithOptions(set("k", "q", 1, "v"), MutateOptions{Durability: tt.durability})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := readValue(t, tb, "k", "q"); got != "v" {
+				t.Fatalf("k = %q before reopening, want v", got)
+			}
+
+			tb = reopenTestTablet(t, tb)
+			if got := readValue(t, tb, "k", "q"); (got == "v") != tt.logged {
+				t.Errorf("k = %q after reopening, want it recovered: %v", got, tt.logged)
+			}
+		})
+	}
+}
+
+func TestUnloggedMutationSurvivesOnceFlushed(t *testing.T) {
+	tb := openTestTablet(t, t.TempDir(), testOptions())
+	mustMutate(t, tb, set("a", "q", 1, "logged"))
+	if err := tb.MutateWithOptions(set("b", "q", 1, "unlogged"), MutateOptions{Durability: DurabilityNone}); err != nil {
+		t.Fatal(err)
+	}
+	flushTestTablet(t, tb)
+	mustMutate(t, tb, set("a", "q", 2, "after flush"))
+
+	tb = reopenTestTablet(t, tb)
+	if got := readValue(t, tb, "a", "q"); got != "after flush" {
+		t.Errorf("a = %q, want after flush", got)
+	}
+	if got := readValue(t, tb, "b", "q"); got != "unlogged" {
+		t.Errorf("b = %q, want unlogged", got)
+	}
+}
+
+func TestWALRecordChecksum(t *testing.T) {
+	frame, err := encodeWALRecord(&LogRecord{Seq: 7, Mutation: set("k", "q", 1, "v")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	rec, n, reason := decodeWALRecord(frame)
+	if reason != "" {
+		t.Fatalf("decode failed: %s", reason)
+	}
+	if n != len(frame) || rec.Seq != 7 || rec.Mutation.RowKey != "k" {
+		t.Errorf("decoded seq %d row %q from %d of %d bytes", rec.Seq, rec.Mutation.RowKey, n, len(frame))
+	}
+
+	frame[len(frame)-1] ^= 0xff
+	if _, _, reason := decodeWALRecord(frame); reason != "checksum mismatch" {
+		t.Errorf("decoding a flipped byte failed with %q, want checksum mismatch", reason)
+	}
+}
+
+// damageTestSegment rewrites the segment of dir that starts at first.
+func damageTestSegment(t *testing.T, dir string, first uint64, damage func([]byte) []byte) {
+	t.Helper()
+	path := filepath.Join(dir, fmt.Sprintf("%020d%s", first, walSegmentExt))
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, damage(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// walRecordOffset returns the offset of the i-th record of a segment.
+func walRecordOffset(data []byte, i int) int {
+	off := len(walSegmentMagic)
+	for ; i > 0; i-- {
+		off += walRecordHeaderSize + int(binary.LittleEndian.Uint32(data[off:]))
+	}
+	return off
+}
+
+func TestRecoverDiscardsTornTail(t *testing.T) {
+	tests := []struct {
+		name   string
+		damage func([]byte) []byte
+		want   []uint64
+	}{
+		{"truncated record", func(b []byte) []byte { return b[:len(b)-3] }, []uint64{1, 2}},
+		{"truncated header", func(b []byte) []byte { return b[:walRecordOffset(b, 2)+5] }, []uint64{1, 2}},
+		{"zeroed tail", func(b []byte) []byte { return append(b, make([]byte, 64)...) }, []uint64{1, 2, 3}},
+		{"zeroed record", func(b []byte) []byte {
+			off := walRecordOffset(b, 2)
+			return append(b[:off], make([]byte, len(b)-off)...)
+		}, []uint64{1, 2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			l, _ := recoverTestLog(t, dir, CommitLogOptions{StrictRecovery: true})
+			appendTestRecords(t, l, "a", "b", "c")
+			l.Close()
+			damageTestSegment(t, dir, 1, tt.damage)
+
+			l, records := recoverTestLog(t, dir, CommitLogOptions{StrictRecovery: true})
+			if got := recordSeqs(records); !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("recovered %v, want %v", got, tt.want)
+			}
+			appendTestRecords(t, l, "d")
+			l.Close()
+
+			// The tail was cut off, so the segment is clean once a newer one follows it.
+			l, records = recoverTestLog(t, dir, CommitLogOptions{StrictRecovery: true})
+			defer l.Close()
+			want := append(tt.want, tt.want[len(tt.want)-1]+1)
+			if got := recordSeqs(records); !reflect.DeepEqual(got, want) {
+				t.Errorf("recovered %v after appending, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestRecoverCorruptionInTheMiddle(t *testing.T) {
+	tests := []struct {
+		name   string
+		damage func([]byte) []byte
+		want   []uint64
+	}{
+		// The length still frames the record, so only it is lost.
+		{"flipped payload byte", func(b []byte) []byte {
+			b[walRecordOffset(b, 1)+walRecordHeaderSize+1] ^= 0xff
+			return b
+		}, []uint64{1, 3, 4, 5}},
+		// Nothing says where the next record starts, so the rest of the segment is lost.
+		{"invalid length", func(b []byte) []byte {
+			binary.LittleEndian.PutUint32(b[walRecordOffset(b, 1):], maxWALRecordSize+1)
+			return b
+		}, []uint64{1, 4, 5}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			l, _ := recoverTestLog(t, dir, CommitLogOptions{})
+			appendTestRecords(t, l, "a", "b", "c")
+			if err := l.Rotate(); err != nil {
+				t.Fatal(err)
+			}
+			appendTestRecords(t, l, "d", "e")
+			l.Close()
+			// Damage the second record of the first segment.
+			damageTestSegment(t, dir, 1, tt.damage)
+
+			l, err := NewCommitLog(dir, CommitLogOptions{StrictRecovery: true})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := l.Recover(); !errors.Is(err, ErrCorruptCommitLog) {
+				t.Fatalf("strict Recover = %v, want ErrCorruptCommitLog", err)
+			}
+
+			l, records := recoverTestLog(t, dir, CommitLogOptions{})
+			defer l.Close()
+			if got := recordSeqs(records); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("recovered %v, want the records around the damage %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// A damaged record in the middle of the last segment is not mistaken for a torn tail.
+func TestRecoverSkipsCorruptRecordBeforeTornTail(t *testing.T) {
+	dir := t.TempDir()
+	l, _ := recoverTestLog(t, dir, CommitLogOptions{})
+	appendTestRecords(t, l, "a", "b", "c")
+	l.Close()
+	damageTestSegment(t, dir, 1, func(b []byte) []byte {
+		b[walRecordOffset(b, 1)+walRecordHeaderSize+1] ^= 0xff
+		return b[:len(b)-3]
+	})
+
+	l, records := recoverTestLog(t, dir, CommitLogOptions{})
+	if got := recordSeqs(records); !reflect.DeepEqual(got, []uint64{1}) {
+		t.Fatalf("recovered %v, want [1]", got)
+	}
+	appendTestRecords(t, l, "d")
+	l.Close()
+
+	l, records = recoverTestLog(t, dir, CommitLogOptions{})
+	defer l.Close()
+	if got := recordSeqs(records); !reflect.DeepEqual(got, []uint64{1, 2}) {
+		t.Errorf("recovered %v after appending, want [1 2]", got)
+	}
+}
+
+func TestTabletOpensAfterTornAppend(t *testing.T) {
+	tb := openTestTablet(t, t.TempDir(), testOptions())
+	mustMutate(t, tb, set("a", "q", 1, "a1"))
+	mustMutate(t, tb, set("b", "q", 1, "b1"))
+	if err := tb.Close(); err != nil {
+		t.Fatal(err)
+	}
+	segments, err := listWALSegments(tb.Dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	damageTestSegment(t, tb.Dir, segments[len(segments)-1], func(b []byte) []byte {
+		return b[:len(b)-1]
+	})
+
+	tb = openTestTablet(t, tb.Dir, tb.Options)
+	if got := readValue(t, tb, "a", "q"); got != "a1" {
+		t.Errorf("a = %q, want a1", got)
+	}
+	if got := readValue(t, tb, "b", "q"); got != "" {
+		t.Errorf("b = %q from a torn record, want nothing", got)
+	}
+	mustMutate(t, tb, set("c", "q", 1, "c1"))
+	tb = reopenTestTablet(t, tb)
+	if got := readValue(t, tb, "c", "q"); got != "c1" {
+		t.Errorf("c = %q, want c1", got)
+	}
+}
@@ -1,78 +1,141 @@
 package tablet
 
 import (
-	"encoding/json"
-	"os"
 	"sort"
+	"time"
 )
 
-// Compact merges multiple SSTable files into a single new SSTable file.
-// It removes superseded versions according to basic logic (merging versions).
-// For this "Basic" implementation, we load everything into memory.
-func Compact(inputPaths []string, outputPath string) error {
-	mergedRows := make(map[string]*Row)
+// CompactionOptions controls a compaction.
+type CompactionOptions struct {
+	// SSTable controls the layout of the output file.
+	SSTable SSTableOptions
+	// Major is set when the inputs include the oldest SSTable of the tablet, so
+	// that no older data remains that tombstones could shadow. Tombstones are
+	// only dropped then.
+	Major bool
+	// Schema supplies the GC rule of each column family. Nil keeps every version.
+	Schema *Schema
+}
 
-	// 1. Load all rows
+// Compact merges multiple SSTable files, ordered oldest first, into a single new SSTable file.
+// It removes superseded versions according to basic logic (merging versions).
+//
+// Versions covered by a tombstone of a newer input or collected by their family's
+// GC rule are always dropped. The tombstones themselves are only dropped by a
+// major compaction.
+func Compact(inputPaths []string, outputPath string, opts CompactionOptions) error {
+	readers := make([]*SSTableReader, 0, len(inputPaths))
+	defer func() {
+		for _, r := range readers {
+			r.unref()
+		}
+	}()
 	for _, path := range inputPaths {
-		rows, err := ReadSSTable(path)
+		r, err := OpenSSTable(path)
 		if err != nil {
 			return err
 		}
-
-		for _, r := range rows {
-			existing, ok := mergedRows[r.Key]
-			if !ok {
-				mergedRows[r.Key] = r
-				continue
-			}
-			// Merge 'r' into 'existing'
-			mergeRows(existing, r)
-		}
+		readers = append(readers, r)
 	}
+	return compactReaders(readers, outputPath, opts)
+}
+
+// compactReaders streams the merged contents of readers, ordered oldest first,
+// into a new SSTable. Rows are merged with a k-way merge, so only one block per
+// input is held in memory.
+func compactReaders(readers []*SSTableReader, outputPath string, opts CompactionOptions) error {
+	now := time.Now().UnixNano()
 
-	// 2. Sort keys
-	var keys []string
-	for k := range mergedRows {
-		keys = append(keys, k)
+	iters := make([]RowIterator, 0, len(readers))
+	for i := len(readers) - 1; i >= 0; i-- {
+		iters = append(iters, newSSTableIterator(readers[i], "", ""))
 	}
-	sort.Strings(keys)
+	it := newMergeIterator(iters)
+	defer it.Close()
 
-	// 3. Write output
-	f, err := os.Create(outputPath)
+	w, err := NewSSTableWriter(outputPath, opts.SSTable)
 	if err != nil {
 		return err
 	}
-	defer f.Close()
 
-	enc := json.NewEncoder(f)
-	for _, k := range keys {
-		if err := enc.Encode(mergedRows[k]); err != nil {
+	for it.Next() {
+		row := it.Row()
+		if opts.Schema != nil {
+			row.applyGC(opts.Schema, now)
+		}
+		if opts.Major {
+			row.dropTombstones()
+		}
+		if len(row.Columns) == 0 && !row.hasTombstones() {
+			continue
+		}
+		if err := w.Add(row); err != nil {
+			w.Abort()
 			return err
 		}
 	}
+	if err := it.Err(); err != nil {
+		w.Abort()
+		return err
+	}
 
-	return nil
+	return w.Finish()
 }
 
 // mergeRows merges 'source' into 'dest'.
-// 'dest' is modified in place.
+// 'dest' is modified in place. 'source' must be older than everything merged
+// into 'dest' so far: of two cells with the same timestamp, dest's is kept, and
+// the versions of source covered by dest's tombstones are dropped. The
+// tombstones of source are merged too, but never shadow dest's versions, which
+// were written after them.
 func mergeRows(dest, source *Row) {
+	for _, col := range source.Columns {
+		col.removeVersions(func(ts int64) bool { return dest.shadowed(col.Family, col.Qualifier, ts) })
+	}
+
+	if source.DeletedAt > dest.DeletedAt {
+		dest.DeletedAt = source.DeletedAt
+	}
+	for family, ts := range source.FamilyDeletedAt {
+		if ts > dest.FamilyDeletedAt[family] {
+			dest.FamilyDeletedAt[family] = ts
+		}
+	}
+
 	for colKey, sourceCol := range source.Columns {
 		destCol, exists := dest.Columns[colKey]
 		if !exists {
 			// Deep copy to be safe? Or just pointer assign given we are doing GC compaction?
 			// Pointer assign is okay for this scope.
-			dest.Columns[colKey] = sourceCol
+			if len(sourceCol.Versions) > 0 || len(sourceCol.Tombstones) > 0 {
+				dest.Columns[colKey] = sourceCol
+			}
 			continue
 		}
+		for _, tr := range sourceCol.Tombstones {
+			destCol.addTombstone(tr)
+		}
+
 		// Merge versions
 		destCol.Versions = append(destCol.Versions, sourceCol.Versions...)
-		
+
 		// Sort versions descending
-		sort.Slice(destCol.Versions, func(i, j int) bool {
+		sort.SliceStable(destCol.Versions, func(i, j int) bool {
 			return destCol.Versions[i].Timestamp > destCol.Versions[j].Timestamp
 		})
 
-		// TODO: De-duplication or retention policies could go here (e.g. keep top 3).
+		// A cell is identified by its timestamp: a newer write overwrites it, and
+		// the same write can reach several sources (e.g. replayed from the log and
+		// already flushed), so keep the newest copy, which the stable sort left first.
+		deduped := destCol.Versions[:0]
+		for _, v := range destCol.Versions {
+			if n := len(deduped); n > 0 && v.Timestamp == deduped[n-1].Timestamp {
+				continue
+			}
+			deduped = append(deduped, v)
+		}
+		destCol.Versions = deduped
+
+		// Retention policies are applied by the caller (see GCRule).
 	}
 }
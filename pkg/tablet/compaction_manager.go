@@ -0,0 +1,264 @@
+package tablet
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// CompactionLimiter bounds how many compactions run at once.
+// One limiter can be shared by every tablet of a server to cap its total compaction I/O.
+type CompactionLimiter struct {
+	slots chan struct{}
+}
+
+// NewCompactionLimiter allows up to n concurrent compactions.
+func NewCompactionLimiter(n int) *CompactionLimiter {
+	if n < 1 {
+		n = 1
+	}
+	return &CompactionLimiter{slots: make(chan struct{}, n)}
+}
+
+// acquire blocks until a slot is free. It returns false if stop is closed first.
+func (l *CompactionLimiter) acquire(stop <-chan struct{}) bool {
+	select {
+	case l.slots <- struct{}{}:
+		return true
+	case <-stop:
+		return false
+	}
+}
+
+func (l *CompactionLimiter) release() {
+	<-l.slots
+}
+
+// compactionManager runs background compactions for one tablet.
+// It wakes up whenever an SSTable is added (see trigger) and periodically, asks the
+// strategy for work, and runs each compaction in its own goroutine within the limiter's budget.
+type compactionManager struct {
+	t        *Tablet
+	strategy CompactionStrategy
+	limiter  *CompactionLimiter
+	interval time.Duration
+
+	wake chan struct{}
+	stop chan struct{}
+	wg   sync.WaitGroup
+
+	mu         sync.Mutex
+	compacting map[string]bool // Paths of SSTables owned by a running compaction
+}
+
+func newCompactionManager(t *Tablet, opts Options) *compactionManager {
+	limiter := opts.CompactionLimiter
+	if limiter == nil {
+		limiter = NewCompactionLimiter(1)
+	}
+	interval := opts.CompactionInterval
+	if interval <= 0 {
+		interval = time.Minute
+	}
+	return &compactionManager{
+		t:          t,
+		strategy:   opts.CompactionStrategy,
+		limiter:    limiter,
+		interval:   interval,
+		wake:       make(chan struct{}, 1),
+		stop:       make(chan struct{}),
+		compacting: make(map[string]bool),
+	}
+}
+
+func (cm *compactionManager) start() {
+	cm.wg.Add(1)
+	go cm.run()
+}
+
+// close stops scheduling and waits for running compactions to finish.
+func (cm *compactionManager) close() {
+	close(cm.stop)
+	cm.wg.Wait()
+}
+
+// trigger asks the manager to look for work soon. It never blocks.
+func (cm *compactionManager) trigger() {
+	select {
+	case cm.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (cm *compactionManager) run() {
+	defer cm.wg.Done()
+
+	ticker := time.NewTicker(cm.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-cm.stop:
+			return
+		case <-cm.wake:
+		case <-ticker.C:
+		}
+		cm.schedule()
+	}
+}
+
+// schedule starts compactions until the strategy has nothing more to pick.
+func (cm *compactionManager) schedule() {
+	for {
+		inputs, major := cm.pick()
+		if inputs == nil {
+			return
+		}
+		if !cm.limiter.acquire(cm.stop) {
+			cm.finish(inputs)
+			return
+		}
+
+		cm.wg.Add(1)
+		go func() {
+			defer cm.wg.Done()
+			defer cm.limiter.release()
+
+			if err := cm.t.compact(inputs, major); err != nil {
+				fmt.Printf("Compaction failed in %s: %v\n", cm.t.Dir, err)
+			}
+			cm.finish(inputs)
+			cm.trigger()
+		}()
+	}
+}
+
+// pick asks the strategy for inputs among the SSTables not already being compacted,
+// and claims them. major reports whether the inputs start with the oldest SSTable:
+// every other source then holds newer data, which tombstones never shadow, so
+// the compaction can drop them.
+// Each returned reader carries an extra reference, dropped by finish.
+//
+// The output of a compaction takes the place of its inputs in the SSTables, which
+// are ordered oldest first, so the inputs must be contiguous: the strategy picks
+// within each run of files that are not being compacted, and its pick is widened
+// to the files in between.
+func (cm *compactionManager) pick() (inputs []SSTableMetadata, major bool) {
+	cm.t.mu.RLock()
+	defer cm.t.mu.RUnlock()
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+
+	for start := 0; start < len(cm.t.SSTables) && inputs == nil; {
+		end := start
+		for end < len(cm.t.SSTables) && !cm.compacting[cm.t.SSTables[end].Path] {
+			end++
+		}
+		if end-start >= 2 {
+			inputs = pickContiguous(cm.strategy, cm.t.SSTables[start:end])
+		}
+		start = end + 1
+	}
+	if inputs == nil {
+		return nil, false
+	}
+	for _, sst := range inputs {
+		cm.compacting[sst.Path] = true
+		sst.reader.ref()
+	}
+	return inputs, inputs[0].Path == cm.t.SSTables[0].Path
+}
+
+// pickContiguous asks the strategy to pick among run, and returns the part of
+// run from the first picked file to the last, or nil if fewer than two are picked.
+func pickContiguous(strategy CompactionStrategy, run []SSTableMetadata) []SSTableMetadata {
+	picked := make(map[string]bool)
+	for _, sst := range strategy.Pick(run) {
+		picked[sst.Path] = true
+	}
+	if len(picked) < 2 {
+		return nil
+	}
+	first, last := -1, -1
+	for i, sst := range run {
+		if picked[sst.Path] {
+			if first < 0 {
+				first = i
+			}
+			last = i
+		}
+	}
+	return append([]SSTableMetadata(nil), run[first:last+1]...)
+}
+
+// finish releases the claim on inputs taken by pick.
+func (cm *compactionManager) finish(inputs []SSTableMetadata) {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	for _, sst := range inputs {
+		delete(cm.compacting, sst.Path)
+		sst.reader.unref()
+	}
+}
+
+// compact merges inputs into a new SSTable, then atomically swaps it in for them.
+// The input files are deleted once no scan uses them any more.
+func (t *Tablet) compact(inputs []SSTableMetadata, major bool) error {
+	t.mu.RLock()
+	opts := CompactionOptions{
+		SSTable: t.Options.SSTable,
+		Major:   major,
+		Schema:  t.Schema,
+	}
+	t.mu.RUnlock()
+
+	readers := make([]*SSTableReader, len(inputs))
+	for i, sst := range inputs {
+		readers[i] = sst.reader
+	}
+
+	outPath := t.nextSSTablePath()
+	if err := compactReaders(readers, outPath, opts); err != nil {
+		return err
+	}
+	out, err := openSSTableMetadata(outPath)
+	if err != nil {
+		return err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	replaced := make(map[string]bool, len(inputs))
+	for _, sst := range inputs {
+		replaced[sst.Path] = true
+		out.MaxSeq = max(out.MaxSeq, sst.MaxSeq)
+	}
+	// The inputs are contiguous, and only flushes added files since they were
+	// picked, after them; the output takes their place to keep the order.
+	live := make([]SSTableMetadata, 0, len(t.SSTables)-len(inputs)+1)
+	for _, sst := range t.SSTables {
+		if !replaced[sst.Path] {
+			live = append(live, sst)
+			continue
+		}
+		// Everything may have been garbage collected; don't keep an empty file around.
+		if sst.Path == inputs[0].Path && len(out.reader.index) > 0 {
+			live = append(live, *out)
+		}
+	}
+	// Until the manifest is saved the inputs stay live, on disk and in memory.
+	err = t.saveManifestLocked(live, t.CommitLog.FlushedSeq())
+	if err != nil || len(out.reader.index) == 0 {
+		out.reader.retire()
+	}
+	if err != nil {
+		return err
+	}
+	t.SSTables = live
+
+	for _, sst := range inputs {
+		sst.reader.retire()
+	}
+	return nil
+}
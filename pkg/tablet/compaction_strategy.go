@@ -0,0 +1,83 @@
+package tablet
+
+import "sort"
+
+// CompactionStrategy picks the SSTables of a tablet to merge next.
+// Implementations must be safe for use by several tablets at once.
+type CompactionStrategy interface {
+	// Pick returns the SSTables to compact together, or nil when nothing needs compacting.
+	// candidates are contiguous files, oldest first, that are not already being
+	// compacted. Files between two picked ones are compacted too, since a
+	// compaction must merge files of consecutive ages.
+	Pick(candidates []SSTableMetadata) []SSTableMetadata
+}
+
+// SizeTieredStrategy groups SSTables of similar size into buckets and compacts a bucket
+// once it holds enough files. Merging files of similar size keeps write amplification
+// low while bounding the number of files a read has to consult.
+type SizeTieredStrategy struct {
+	// MinThreshold is the number of similarly sized files that triggers a compaction.
+	MinThreshold int
+	// MaxThreshold caps the number of files merged at once.
+	MaxThreshold int
+	// BucketLow and BucketHigh bound the sizes, relative to a bucket's average,
+	// of the files that belong to it.
+	BucketLow, BucketHigh float64
+	// MinSSTableSize puts every file smaller than this into a single bucket,
+	// so that many tiny flushes are merged regardless of their exact sizes.
+	MinSSTableSize int64
+}
+
+// NewSizeTieredStrategy returns a SizeTieredStrategy with the usual defaults.
+func NewSizeTieredStrategy() *SizeTieredStrategy {
+	return &SizeTieredStrategy{
+		MinThreshold:   4,
+		MaxThreshold:   32,
+		BucketLow:      0.5,
+		BucketHigh:     1.5,
+		MinSSTableSize: 1 << 20,
+	}
+}
+
+// Pick implements CompactionStrategy.
+// Among the buckets that reached MinThreshold, the one with the smallest files wins,
+// since it is the cheapest to compact.
+func (s *SizeTieredStrategy) Pick(candidates []SSTableMetadata) []SSTableMetadata {
+	sorted := append([]SSTableMetadata(nil), candidates...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Size < sorted[j].Size })
+
+	type bucket struct {
+		files []SSTableMetadata
+		total int64
+	}
+	var buckets []*bucket
+	for _, sst := range sorted {
+		placed := false
+		for _, b := range buckets {
+			avg := b.total / int64(len(b.files))
+			small := sst.Size < s.MinSSTableSize && avg < s.MinSSTableSize
+			similar := float64(sst.Size) >= float64(avg)*s.BucketLow && float64(sst.Size) <= float64(avg)*s.BucketHigh
+			if small || similar {
+				b.files = append(b.files, sst)
+				b.total += sst.Size
+				placed = true
+				break
+			}
+		}
+		if !placed {
+			buckets = append(buckets, &bucket{files: []SSTableMetadata{sst}, total: sst.Size})
+		}
+	}
+
+	// Buckets were created in increasing size order, so the first eligible one is the smallest.
+	for _, b := range buckets {
+		if len(b.files) < s.MinThreshold {
+			continue
+		}
+		if s.MaxThreshold > 0 && len(b.files) > s.MaxThreshold {
+			return b.files[:s.MaxThreshold]
+		}
+		return b.files
+	}
+	return nil
+}
@@ -0,0 +1,206 @@
+package tablet
+
+import (
+	"fmt"
+	"testing"
+)
+
+// scanValues returns the latest value of cf:q of every row, by row key.
+func scanValues(t *testing.T, tb *Tablet) map[string]string {
+	t.Helper()
+	it, err := tb.Scan("", "", ScanOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer it.Close()
+	values := make(map[string]string)
+	for it.Next() {
+		if v := it.Row().Get("cf", "q"); v != nil {
+			values[it.Row().Key] = string(v.Value)
+		}
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+	return values
+}
+
+// compactTestTablet compacts the SSTables of tb from index i to j, exclusive.
+func compactTestTablet(t *testing.T, tb *Tablet, i, j int) {
+	t.Helper()
+	tb.mu.RLock()
+	inputs := append([]SSTableMetadata(nil), tb.SSTables[i:j]...)
+	major := i == 0
+	tb.mu.RUnlock()
+	if err := tb.compact(inputs, major); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestOverwriteSameTimestampAcrossSources(t *testing.T) {
+	tb := openTestTablet(t, t.TempDir(), testOptions())
+	for _, v := range []string{"A", "B", "C"} {
+		mustMutate(t, tb, set("k", "q", 5, v))
+		flushTestTablet(t, tb)
+	}
+	mustMutate(t, tb, set("m", "q", 5, "X"))
+	mustMutate(t, tb, set("m", "q", 5, "Y"))
+
+	check := func(when string) {
+		t.Helper()
+		if got := readValue(t, tb, "k", "q"); got != "C" {
+			t.Errorf("%s: Read(k) = %q, want C", when, got)
+		}
+		if got := readValue(t, tb, "m", "q"); got != "Y" {
+			t.Errorf("%s: Read(m) = %q, want Y", when, got)
+		}
+		versions, err := tb.ReadVersions("k", "cf", "q", ReadOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(versions) != 1 {
+			t.Errorf("%s: k has %d versions, want 1", when, len(versions))
+		}
+		if got := scanValues(t, tb); got["k"] != "C" || got["m"] != "Y" {
+			t.Errorf("%s: Scan = %v, want k=C m=Y", when, got)
+		}
+	}
+	check("before compaction")
+	compactTestTablet(t, tb, 0, 2)
+	check("after compacting the two oldest files")
+	compactTestTablet(t, tb, 0, 2)
+	check("after compacting everything")
+	tb = reopenTestTablet(t, tb)
+	check("after reopening")
+}
+
+func TestCompactionKeepsFileOrder(t *testing.T) {
+	tb := openTestTablet(t, t.TempDir(), testOptions())
+	for i := 0; i < 4; i++ {
+		mustMutate(t, tb, set("k", "q", 5, fmt.Sprint(i)))
+		flushTestTablet(t, tb)
+	}
+	// The output of the middle files must stay older than the newest file.
+	compactTestTablet(t, tb, 1, 3)
+	if n := len(tb.SSTables); n != 3 {
+		t.Fatalf("got %d SSTables, want 3", n)
+	}
+	if got := readValue(t, tb, "k", "q"); got != "3" {
+		t.Errorf("Read(k) = %q, want 3", got)
+	}
+	tb = reopenTestTablet(t, tb)
+	if got := readValue(t, tb, "k", "q"); got != "3" {
+		t.Errorf("Read(k) = %q after reopening, want 3", got)
+	}
+}
+
+// pickStrategy picks the files at the given positions of the candidates.
+type pickStrategy []int
+
+func (s pickStrategy) Pick(candidates []SSTableMetadata) []SSTableMetadata {
+	var picked []SSTableMetadata
+	for _, i := range s {
+		if i < len(candidates) {
+			picked = append(picked, candidates[i])
+		}
+	}
+	return picked
+}
+
+func TestPickContiguous(t *testing.T) {
+	run := make([]SSTableMetadata, 5)
+	for i := range run {
+		run[i].Path = fmt.Sprint(i)
+	}
+	tests := []struct {
+		picked pickStrategy
+		want   string
+	}{
+		{pickStrategy{1, 3}, "123"},
+		{pickStrategy{4, 0}, "01234"},
+		{pickStrategy{2, 3}, "23"},
+		{pickStrategy{2}, ""},
+		{nil, ""},
+	}
+	for _, tt := range tests {
+		got := ""
+		for _, sst := range pickContiguous(tt.picked, run) {
+			got += sst.Path
+		}
+		if got != tt.want {
+			t.Errorf("pickContiguous(%v) = %q, want %q", tt.picked, got, tt.want)
+		}
+	}
+}
+
+func TestBackgroundCompaction(t *testing.T) {
+	opts := testOptions()
+	strategy := NewSizeTieredStrategy()
+	strategy.MinThreshold = 2
+	opts.CompactionStrategy = strategy
+	tb := openTestTablet(t, t.TempDir(), opts)
+
+	for i := 0; i < 6; i++ {
+		mustMutate(t, tb, set(fmt.Sprintf("row%d", i), "q", 5, fmt.Sprint(i)))
+		mustMutate(t, tb, set("k", "q", 5, fmt.Sprint(i)))
+		flushTestTablet(t, tb)
+	}
+	tb = reopenTestTablet(t, tb)
+
+	values := scanValues(t, tb)
+	if values["k"] != "5" {
+		t.Errorf("k = %q, want 5", values["k"])
+	}
+	for i := 0; i < 6; i++ {
+		if got := values[fmt.Sprintf("row%d", i)]; got != fmt.Sprint(i) {
+			t.Errorf("row%d = %q, want %d", i, got, i)
+		}
+	}
+}
+
+func TestMajorCompactionOnlyWithOldestFile(t *testing.T) {
+	tests := []struct {
+		name      string
+		picked    pickStrategy
+		wantMajor bool
+	}{
+		{"oldest files", pickStrategy{0, 1}, true},
+		{"newest files", pickStrategy{1, 2}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tb := openTestTablet(t, t.TempDir(), testOptions())
+			mustMutate(t, tb, set("k", "q", 5, "old"))
+			flushTestTablet(t, tb)
+			mustMutate(t, tb, deleteRow())
+			flushTestTablet(t, tb)
+			mustMutate(t, tb, set("k", "other", 5, "new"))
+			flushTestTablet(t, tb)
+			// Unflushed data, which the tombstone must not shadow either.
+			mustMutate(t, tb, set("k", "q", 4, "unflushed"))
+
+			cm := newCompactionManager(tb, tb.Options)
+			cm.strategy = tt.picked
+			inputs, major := cm.pick()
+			if major != tt.wantMajor {
+				t.Fatalf("major = %v, want %v", major, tt.wantMajor)
+			}
+			if err := tb.compact(inputs, major); err != nil {
+				t.Fatal(err)
+			}
+			cm.finish(inputs)
+
+			if got := readValue(t, tb, "k", "q"); got != "unflushed" {
+				t.Errorf("k cf:q = %q, want unflushed", got)
+			}
+			if got := readValue(t, tb, "k", "other"); got != "new" {
+				t.Errorf("k cf:other = %q, want new", got)
+			}
+			flushTestTablet(t, tb)
+			compactTestTablet(t, tb, 0, len(tb.SSTables))
+			if got := readValue(t, tb, "k", "q"); got != "unflushed" {
+				t.Errorf("k cf:q = %q after a full compaction, want unflushed", got)
+			}
+		})
+	}
+}
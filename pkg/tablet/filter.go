@@ -0,0 +1,351 @@
+package tablet
+
+import (
+	"bytes"
+	"fmt"
+	"math/rand/v2"
+	"regexp"
+	"sort"
+)
+
+// RowFilter narrows the cells of a row on the server, modeled on Bigtable's RowFilter.
+//
+// A filter sees the live cells of one row as a list ordered by family, then
+// qualifier, then timestamp descending, and outputs a subset of them.
+// Exactly one field may be set; the zero value passes every cell.
+// Filters are plain structs so that they travel as JSON on the wire.
+type RowFilter struct {
+	// Chain applies the filters in sequence, each to the output of the previous one.
+	Chain []*RowFilter `json:",omitempty"`
+	// Interleave applies every filter to the input and outputs the union of their
+	// results, in cell order. A cell passed by several filters appears several times.
+	Interleave []*RowFilter `json:",omitempty"`
+	// Condition applies one filter or another depending on a predicate.
+	Condition *FilterCondition `json:",omitempty"`
+
+	// FamilyRegex keeps cells whose column family fully matches the RE2 expression.
+	FamilyRegex string `json:",omitempty"`
+	// QualifierRegex keeps cells whose qualifier fully matches the RE2 expression.
+	QualifierRegex string `json:",omitempty"`
+	// QualifierRange keeps cells of one family with qualifiers in a range.
+	QualifierRange *ColumnRange `json:",omitempty"`
+	// ValueRegex keeps cells whose value fully matches the RE2 expression.
+	ValueRegex string `json:",omitempty"`
+	// ValueRange keeps cells with values in a range.
+	ValueRange *ValueRange `json:",omitempty"`
+	// TimestampRange keeps cells with timestamps in the range.
+	TimestampRange *TimestampRange `json:",omitempty"`
+
+	// CellsPerColumnLimit keeps the newest N cells of every column.
+	CellsPerColumnLimit int `json:",omitempty"`
+	// CellsPerRowOffset skips the first N cells of the row.
+	CellsPerRowOffset int `json:",omitempty"`
+	// CellsPerRowLimit keeps the first N cells of the row.
+	CellsPerRowLimit int `json:",omitempty"`
+	// RowSampleProbability keeps each row, whole, with this probability in (0, 1].
+	RowSampleProbability float64 `json:",omitempty"`
+
+	// StripValue replaces every value with an empty one, e.g. to list columns cheaply.
+	StripValue bool `json:",omitempty"`
+	// BlockAll outputs no cells. It is mostly useful as a Condition branch.
+	BlockAll bool `json:",omitempty"`
+}
+
+// FilterCondition outputs True applied to the row if Predicate outputs any cell
+// for it, and False applied to the row otherwise. A nil branch outputs nothing.
+type FilterCondition struct {
+	Predicate *RowFilter
+	True      *RowFilter `json:",omitempty"`
+	False     *RowFilter `json:",omitempty"`
+}
+
+// ColumnRange is the range of qualifiers [Start, End) within one family.
+// An empty End means the range has no upper bound.
+type ColumnRange struct {
+	Family string
+	Start  string
+	End    string `json:",omitempty"`
+}
+
+// ValueRange is the range of values [Start, End), compared bytewise.
+// A nil End means the range has no upper bound.
+type ValueRange struct {
+	Start []byte `json:",omitempty"`
+	End   []byte `json:",omitempty"`
+}
+
+// Validate reports whether the filter is well formed, e.g. has valid regular expressions.
+func (f *RowFilter) Validate() error {
+	_, err := compileRowFilter(f)
+	return err
+}
+
+// filterCell is a single version of a column, as seen by row filters.
+type filterCell struct {
+	family    string
+	qualifier string
+	version   CellVersion
+}
+
+// filterFunc is a compiled RowFilter. It must not modify its input.
+type filterFunc func(cells []filterCell) []filterCell
+
+// compileRowFilter checks a filter and turns it into a filterFunc.
+// A nil filter passes every cell.
+func compileRowFilter(f *RowFilter) (filterFunc, error) {
+	if f == nil {
+		return passAll, nil
+	}
+
+	var fns []filterFunc
+	add := func(fn filterFunc) { fns = append(fns, fn) }
+
+	if f.Chain != nil {
+		chain, err := compileRowFilters(f.Chain)
+		if err != nil {
+			return nil, fmt.Errorf("chain: %w", err)
+		}
+		add(func(cells []filterCell) []filterCell {
+			for _, fn := range chain {
+				if len(cells) == 0 {
+					break
+				}
+				cells = fn(cells)
+			}
+			return cells
+		})
+	}
+	if f.Interleave != nil {
+		branches, err := compileRowFilters(f.Interleave)
+		if err != nil {
+			return nil, fmt.Errorf("interleave: %w", err)
+		}
+		add(func(cells []filterCell) []filterCell {
+			var out []filterCell
+			for _, fn := range branches {
+				out = append(out, fn(cells)...)
+			}
+			sortFilterCells(out)
+			return out
+		})
+	}
+	if c := f.Condition; c != nil {
+		if c.Predicate == nil {
+			return nil, fmt.Errorf("condition: missing predicate")
+		}
+		predicate, err := compileRowFilter(c.Predicate)
+		if err != nil {
+			return nil, fmt.Errorf("condition predicate: %w", err)
+		}
+		onTrue, onFalse := blockAll, blockAll
+		if c.True != nil {
+			if onTrue, err = compileRowFilter(c.True); err != nil {
+				return nil, fmt.Errorf("condition true: %w", err)
+			}
+		}
+		if c.False != nil {
+			if onFalse, err = compileRowFilter(c.False); err != nil {
+				return nil, fmt.Errorf("condition false: %w", err)
+			}
+		}
+		add(func(cells []filterCell) []filterCell {
+			if len(predicate(cells)) > 0 {
+				return onTrue(cells)
+			}
+			return onFalse(cells)
+		})
+	}
+
+	if f.FamilyRegex != "" {
+		re, err := compileFullMatch(f.FamilyRegex)
+		if err != nil {
+			return nil, fmt.Errorf("family regex: %w", err)
+		}
+		add(keepCells(func(c filterCell) bool { return re.MatchString(c.family) }))
+	}
+	if f.QualifierRegex != "" {
+		re, err := compileFullMatch(f.QualifierRegex)
+		if err != nil {
+			return nil, fmt.Errorf("qualifier regex: %w", err)
+		}
+		add(keepCells(func(c filterCell) bool { return re.MatchString(c.qualifier) }))
+	}
+	if r := f.QualifierRange; r != nil {
+		if r.Family == "" {
+			return nil, fmt.Errorf("qualifier range: missing family")
+		}
+		add(keepCells(func(c filterCell) bool {
+			return c.family == r.Family && c.qualifier >= r.Start && (r.End == "" || c.qualifier < r.End)
+		}))
+	}
+	if f.ValueRegex != "" {
+		re, err := compileFullMatch(f.ValueRegex)
+		if err != nil {
+			return nil, fmt.Errorf("value regex: %w", err)
+		}
+		add(keepCells(func(c filterCell) bool { return re.Match(c.version.Value) }))
+	}
+	if r := f.ValueRange; r != nil {
+		add(keepCells(func(c filterCell) bool {
+			return bytes.Compare(c.version.Value, r.Start) >= 0 && (r.End == nil || bytes.Compare(c.version.Value, r.End) < 0)
+		}))
+	}
+	if r := f.TimestampRange; r != nil {
+		tr := *r
+		add(keepCells(func(c filterCell) bool { return tr.Contains(c.version.Timestamp) }))
+	}
+
+	if n := f.CellsPerColumnLimit; n != 0 {
+		if n < 0 {
+			return nil, fmt.Errorf("negative cells per column limit %d", n)
+		}
+		add(func(cells []filterCell) []filterCell {
+			var out []filterCell
+			seen := 0
+			for i, c := range cells {
+				if i == 0 || c.family != cells[i-1].family || c.qualifier != cells[i-1].qualifier {
+					seen = 0
+				}
+				if seen < n {
+					out = append(out, c)
+				}
+				seen++
+			}
+			return out
+		})
+	}
+	if n := f.CellsPerRowOffset; n != 0 {
+		if n < 0 {
+			return nil, fmt.Errorf("negative cells per row offset %d", n)
+		}
+		add(func(cells []filterCell) []filterCell {
+			if n >= len(cells) {
+				return nil
+			}
+			return cells[n:]
+		})
+	}
+	if n := f.CellsPerRowLimit; n != 0 {
+		if n < 0 {
+			return nil, fmt.Errorf("negative cells per row limit %d", n)
+		}
+		add(func(cells []filterCell) []filterCell {
+			if n >= len(cells) {
+				return cells
+			}
+			return cells[:n]
+		})
+	}
+	if p := f.RowSampleProbability; p != 0 {
+		if p < 0 || p > 1 {
+			return nil, fmt.Errorf("row sample probability %v not in (0, 1]", p)
+		}
+		add(func(cells []filterCell) []filterCell {
+			if rand.Float64() < p {
+				return cells
+			}
+			return nil
+		})
+	}
+
+	if f.StripValue {
+		add(func(cells []filterCell) []filterCell {
+			out := make([]filterCell, len(cells))
+			for i, c := range cells {
+				c.version.Value = nil
+				out[i] = c
+			}
+			return out
+		})
+	}
+	if f.BlockAll {
+		add(blockAll)
+	}
+
+	switch len(fns) {
+	case 0:
+		return passAll, nil
+	case 1:
+		return fns[0], nil
+	default:
+		return nil, fmt.Errorf("row filter sets %d kinds of filter, want one (use Chain to combine them)", len(fns))
+	}
+}
+
+func compileRowFilters(filters []*RowFilter) ([]filterFunc, error) {
+	fns := make([]filterFunc, len(filters))
+	for i, f := range filters {
+		fn, err := compileRowFilter(f)
+		if err != nil {
+			return nil, err
+		}
+		fns[i] = fn
+	}
+	return fns, nil
+}
+
+// compileFullMatch compiles a regular expression that must match the whole input,
+// as in Bigtable.
+func compileFullMatch(expr string) (*regexp.Regexp, error) {
+	return regexp.Compile(`^(?:` + expr + `)$`)
+}
+
+func passAll(cells []filterCell) []filterCell { return cells }
+
+func blockAll([]filterCell) []filterCell { return nil }
+
+// keepCells builds a filter that keeps the cells matching keep.
+func keepCells(keep func(filterCell) bool) filterFunc {
+	return func(cells []filterCell) []filterCell {
+		var out []filterCell
+		for _, c := range cells {
+			if keep(c) {
+				out = append(out, c)
+			}
+		}
+		return out
+	}
+}
+
+// sortFilterCells puts cells in filter order: family, qualifier, then newest first.
+func sortFilterCells(cells []filterCell) {
+	sort.SliceStable(cells, func(i, j int) bool {
+		a, b := cells[i], cells[j]
+		if a.family != b.family {
+			return a.family < b.family
+		}
+		if a.qualifier != b.qualifier {
+			return a.qualifier < b.qualifier
+		}
+		return a.version.Timestamp > b.version.Timestamp
+	})
+}
+
+// rowFilterCells lists the versions of a row in filter order.
+// The row must not carry tombstones any more.
+func rowFilterCells(r *Row) []filterCell {
+	var cells []filterCell
+	for _, col := range r.Columns {
+		for _, v := range col.Versions {
+			cells = append(cells, filterCell{family: col.Family, qualifier: col.Qualifier, version: v})
+		}
+	}
+	sortFilterCells(cells)
+	return cells
+}
+
+// applyFilter replaces the columns of a row with the cells the filter outputs.
+// The row must not carry tombstones any more.
+func (r *Row) applyFilter(fn filterFunc) {
+	cells := fn(rowFilterCells(r))
+	r.Columns = make(map[string]*Column)
+	for _, c := range cells {
+		colKey := c.family + ":" + c.qualifier
+		col, ok := r.Columns[colKey]
+		if !ok {
+			col = NewColumn(c.family, c.qualifier)
+			r.Columns[colKey] = col
+		}
+		col.Versions = append(col.Versions, c.version)
+	}
+}
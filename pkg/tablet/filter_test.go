@@ -0,0 +1,210 @@
+package tablet
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+// filterTestCells is the row every filter test starts from, in filter order.
+var filterTestCells = []filterCell{
+	{"cf", "a", CellVersion{Timestamp: 3, Value: []byte("x3")}},
+	{"cf", "a", CellVersion{Timestamp: 2, Value: []byte("x2")}},
+	{"cf", "a", CellVersion{Timestamp: 1, Value: []byte("y1")}},
+	{"cf", "b", CellVersion{Timestamp: 5, Value: []byte("10")}},
+	{"other", "a", CellVersion{Timestamp: 4, Value: []byte("z")}},
+}
+
+// cellNames names cells as family:qualifier@timestamp=value.
+func cellNames(cells []filterCell) []string {
+	var names []string
+	for _, c := range cells {
+		names = append(names, fmt.Sprintf("%s:%s@%d=%s", c.family, c.qualifier, c.version.Timestamp, c.version.Value))
+	}
+	return names
+}
+
+func TestRowFilter(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter *RowFilter
+		want   []string
+	}{
+		{"nil", nil, []string{"cf:a@3=x3", "cf:a@2=x2", "cf:a@1=y1", "cf:b@5=10", "other:a@4=z"}},
+		{"zero value", &RowFilter{}, []string{"cf:a@3=x3", "cf:a@2=x2", "cf:a@1=y1", "cf:b@5=10", "other:a@4=z"}},
+		{"block all", &RowFilter{BlockAll: true}, nil},
+
+		{"family regex", &RowFilter{FamilyRegex: "oth.*"}, []string{"other:a@4=z"}},
+		{"family regex matches whole family", &RowFilter{FamilyRegex: "c"}, nil},
+		{"qualifier regex", &RowFilter{QualifierRegex: "b|c"}, []string{"cf:b@5=10"}},
+		{"value regex", &RowFilter{ValueRegex: "x."}, []string{"cf:a@3=x3", "cf:a@2=x2"}},
+		{"value regex matches whole value", &RowFilter{ValueRegex: "x"}, nil},
+		{"qualifier range", &RowFilter{QualifierRange: &ColumnRange{Family: "cf", Start: "a", End: "b"}}, []string{"cf:a@3=x3", "cf:a@2=x2", "cf:a@1=y1"}},
+		{"open qualifier range", &RowFilter{QualifierRange: &ColumnRange{Family: "cf", Start: "b"}}, []string{"cf:b@5=10"}},
+		{"value range", &RowFilter{ValueRange: &ValueRange{Start: []byte("x"), End: []byte("y")}}, []string{"cf:a@3=x3", "cf:a@2=x2"}},
+		{"open value range", &RowFilter{ValueRange: &ValueRange{Start: []byte("y")}}, []string{"cf:a@1=y1", "other:a@4=z"}},
+		{"timestamp range", &RowFilter{TimestampRange: &TimestampRange{Start: 2, End: 4}}, []string{"cf:a@3=x3", "cf:a@2=x2"}},
+
+		{"cells per column", &RowFilter{CellsPerColumnLimit: 1}, []string{"cf:a@3=x3", "cf:b@5=10", "other:a@4=z"}},
+		{"cells per column counts each family", &RowFilter{CellsPerColumnLimit: 2}, []string{"cf:a@3=x3", "cf:a@2=x2", "cf:b@5=10", "other:a@4=z"}},
+		{"cells per row offset", &RowFilter{CellsPerRowOffset: 3}, []string{"cf:b@5=10", "other:a@4=z"}},
+		{"cells per row offset past the row", &RowFilter{CellsPerRowOffset: 9}, nil},
+		{"cells per row limit", &RowFilter{CellsPerRowLimit: 2}, []string{"cf:a@3=x3", "cf:a@2=x2"}},
+		{"cells per row limit past the row", &RowFilter{CellsPerRowLimit: 9}, []string{"cf:a@3=x3", "cf:a@2=x2", "cf:a@1=y1", "cf:b@5=10", "other:a@4=z"}},
+		{"sample everything", &RowFilter{RowSampleProbability: 1}, []string{"cf:a@3=x3", "cf:a@2=x2", "cf:a@1=y1", "cf:b@5=10", "other:a@4=z"}},
+		{"strip value", &RowFilter{Chain: []*RowFilter{{FamilyRegex: "cf"}, {StripValue: true}}}, []string{"cf:a@3=", "cf:a@2=", "cf:a@1=", "cf:b@5="}},
+
+		{"chain", &RowFilter{Chain: []*RowFilter{
+			{QualifierRegex: "a"},
+			{CellsPerColumnLimit: 1},
+		}}, []string{"cf:a@3=x3", "other:a@4=z"}},
+		{"chain runs in order", &RowFilter{Chain: []*RowFilter{
+			{CellsPerColumnLimit: 1},
+			{CellsPerRowOffset: 1},
+		}}, []string{"cf:b@5=10", "other:a@4=z"}},
+		{"empty chain", &RowFilter{Chain: []*RowFilter{}}, []string{"cf:a@3=x3", "cf:a@2=x2", "cf:a@1=y1", "cf:b@5=10", "other:a@4=z"}},
+		{"interleave", &RowFilter{Interleave: []*RowFilter{
+			{FamilyRegex: "other"},
+			{QualifierRegex: "b"},
+		}}, []string{"cf:b@5=10", "other:a@4=z"}},
+		{"interleave keeps duplicates in cell order", &RowFilter{Interleave: []*RowFilter{
+			{TimestampRange: &TimestampRange{Start: 3}},
+			{CellsPerRowLimit: 1},
+		}}, []string{"cf:a@3=x3", "cf:a@3=x3", "cf:b@5=10", "other:a@4=z"}},
+		{"empty interleave", &RowFilter{Interleave: []*RowFilter{}}, nil},
+
+		{"condition true", &RowFilter{Condition: &FilterCondition{
+			Predicate: &RowFilter{ValueRegex: "10"},
+			True:      &RowFilter{FamilyRegex: "other"},
+			False:     &RowFilter{QualifierRegex: "b"},
+		}}, []string{"other:a@4=z"}},
+		{"condition false", &RowFilter{Condition: &FilterCondition{
+			Predicate: &RowFilter{ValueRegex: "11"},
+			True:      &RowFilter{FamilyRegex: "other"},
+			False:     &RowFilter{QualifierRegex: "b"},
+		}}, []string{"cf:b@5=10"}},
+		{"condition sees the whole row", &RowFilter{Condition: &FilterCondition{
+			Predicate: &RowFilter{FamilyRegex: "other"},
+			True:      &RowFilter{CellsPerRowLimit: 1},
+		}}, []string{"cf:a@3=x3"}},
+		{"condition without branch", &RowFilter{Condition: &FilterCondition{
+			Predicate: &RowFilter{ValueRegex: "11"},
+			True:      &RowFilter{},
+		}}, nil},
+		{"condition in a chain", &RowFilter{Chain: []*RowFilter{
+			{FamilyRegex: "cf"},
+			{Condition: &FilterCondition{
+				Predicate: &RowFilter{FamilyRegex: "other"},
+				True:      &RowFilter{},
+				False:     &RowFilter{CellsPerColumnLimit: 1},
+			}},
+		}}, []string{"cf:a@3=x3", "cf:b@5=10"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fn, err := compileRowFilter(tt.filter)
+			if err != nil {
+				t.Fatal(err)
+			}
+			in := append([]filterCell(nil), filterTestCells...)
+			if got := cellNames(fn(in)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("filter output %q, want %q", got, tt.want)
+			}
+			if !reflect.DeepEqual(in, filterTestCells) {
+				t.Errorf("filter modified its input")
+			}
+		})
+	}
+}
+
+func TestRowFilterSample(t *testing.T) {
+	fn, err := compileRowFilter(&RowFilter{RowSampleProbability: 0.5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	kept := 0
+	for i := 0; i < 1000; i++ {
+		switch out := fn(filterTestCells); len(out) {
+		case len(filterTestCells):
+			kept++
+		case 0:
+		default:
+			t.Fatalf("sampling kept %d of %d cells, want the whole row or nothing", len(out), len(filterTestCells))
+		}
+	}
+	if kept < 350 || kept > 650 {
+		t.Errorf("sampling kept %d of 1000 rows, want about half", kept)
+	}
+}
+
+func TestRowFilterValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter *RowFilter
+	}{
+		{"two kinds", &RowFilter{FamilyRegex: "cf", QualifierRegex: "a"}},
+		{"bad family regex", &RowFilter{FamilyRegex: "("}},
+		{"bad qualifier regex", &RowFilter{QualifierRegex: "["}},
+		{"bad value regex", &RowFilter{ValueRegex: "a**"}},
+		{"qualifier range without family", &RowFilter{QualifierRange: &ColumnRange{Start: "a"}}},
+		{"negative cells per column", &RowFilter{CellsPerColumnLimit: -1}},
+		{"negative cells per row offset", &RowFilter{CellsPerRowOffset: -1}},
+		{"negative cells per row limit", &RowFilter{CellsPerRowLimit: -1}},
+		{"sample probability above 1", &RowFilter{RowSampleProbability: 1.5}},
+		{"negative sample probability", &RowFilter{RowSampleProbability: -0.5}},
+		{"condition without predicate", &RowFilter{Condition: &FilterCondition{True: &RowFilter{}}}},
+		{"bad filter in a chain", &RowFilter{Chain: []*RowFilter{{}, {FamilyRegex: "("}}}},
+		{"bad filter in an interleave", &RowFilter{Interleave: []*RowFilter{{CellsPerRowLimit: -1}}}},
+		{"bad condition branch", &RowFilter{Condition: &FilterCondition{Predicate: &RowFilter{}, False: &RowFilter{ValueRegex: "("}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.filter.Validate(); err == nil {
+				t.Error("Validate accepted the filter")
+			}
+		})
+	}
+}
+
+// Filters apply to the row merged from the MemTable and SSTables.
+func TestRowFilterAcrossSources(t *testing.T) {
+	tb := openTestTablet(t, t.TempDir(), testOptions())
+	mustMutate(t, tb, set("a", "q", 1, "old"))
+	mustMutate(t, tb, set("b", "q", 1, "b1"))
+	flushTestTablet(t, tb)
+	mustMutate(t, tb, set("a", "q", 2, "new"))
+	mustMutate(t, tb, set("a", "r", 1, "r1"))
+
+	newest := &RowFilter{Chain: []*RowFilter{{QualifierRegex: "q"}, {CellsPerColumnLimit: 1}}}
+	row, err := tb.ReadRow("a", ReadFilter{Filter: newest})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := cellNames(rowFilterCells(row)); !reflect.DeepEqual(got, []string{"cf:q@2=new"}) {
+		t.Errorf("ReadRow = %q, want the newest cf:q", got)
+	}
+
+	it, err := tb.Scan("", "", ScanOptions{Filter: &RowFilter{ValueRegex: "old|b1"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer it.Close()
+	var got []string
+	for it.Next() {
+		got = append(got, it.Row().Key+"/"+cellNames(rowFilterCells(it.Row()))[0])
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"a/cf:q@1=old", "b/cf:q@1=b1"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Scan = %q, want %q", got, want)
+	}
+
+	matched, err := tb.CheckAndMutate("a", &RowFilter{ValueRegex: "old"}, set("a", "q", 3, "matched"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !matched || readValue(t, tb, "a", "q") != "matched" {
+		t.Errorf("CheckAndMutate on a flushed value = %v, want a match", matched)
+	}
+}
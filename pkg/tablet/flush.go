@@ -0,0 +1,138 @@
+package tablet
+
+import (
+	"fmt"
+	"time"
+)
+
+// flushRetryDelay is how long the flusher waits before retrying a failed flush.
+const flushRetryDelay = time.Second
+
+// rotateMemTable freezes the active MemTable and starts a new one.
+// The frozen MemTable stays readable until the background flusher has
+// replaced it with an SSTable. The CommitLog starts a new segment at the same
+// point, so that segments can be deleted as their MemTables are flushed.
+// It assumes the lock is held.
+func (t *Tablet) rotateMemTable() {
+	t.Immutable = append(t.Immutable, t.MemTable)
+	t.MemTable = NewMemTable()
+
+	if err := t.CommitLog.Rotate(); err != nil {
+		// Not fatal: the current segment just lives until a later flush covers it.
+		fmt.Printf("Failed to rotate commit log in %s: %v\n", t.Dir, err)
+	}
+
+	select {
+	case t.flushWake <- struct{}{}:
+	default:
+	}
+}
+
+// flushLoop is the background minor compaction: it writes frozen MemTables
+// to SSTables, oldest first, while mutations keep going to the active MemTable.
+func (t *Tablet) flushLoop() {
+	defer t.flushWG.Done()
+
+	for {
+		select {
+		case <-t.flushStop:
+			return
+		case <-t.flushWake:
+		}
+
+		for {
+			flushed, err := t.flushOldest()
+			if err != nil {
+				fmt.Printf("Flush failed in %s: %v\n", t.Dir, err)
+				select {
+				case <-t.flushStop:
+					return
+				case <-time.After(flushRetryDelay):
+				}
+				continue
+			}
+			if !flushed {
+				break
+			}
+		}
+	}
+}
+
+// flushOldest flushes the oldest frozen MemTable, if any, and reports whether it did.
+func (t *Tablet) flushOldest() (bool, error) {
+	t.mu.RLock()
+	if len(t.Immutable) == 0 {
+		t.mu.RUnlock()
+		return false, nil
+	}
+	m := t.Immutable[0]
+	t.mu.RUnlock()
+
+	// Write without the tablet lock so that reads and mutations are not blocked.
+	meta, err := m.WriteSSTable(t.nextSSTablePath(), t.Options.SSTable)
+	if err != nil {
+		return false, err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if len(t.Immutable) == 0 || t.Immutable[0] != m {
+		// Someone else (e.g. Split) flushed it in the meantime.
+		meta.reader.retire()
+		return true, nil
+	}
+	if err := t.publishFlush(meta, m.LastSeq); err != nil {
+		return false, err
+	}
+	t.Immutable = t.Immutable[1:]
+	return true, nil
+}
+
+// flushAllLocked synchronously flushes every frozen MemTable and the active one,
+// leaving all data in SSTables. It assumes the lock is held.
+func (t *Tablet) flushAllLocked() error {
+	t.rotateMemTable()
+	for len(t.Immutable) > 0 {
+		meta, err := t.Immutable[0].WriteSSTable(t.nextSSTablePath(), t.Options.SSTable)
+		if err != nil {
+			return err
+		}
+		if err := t.publishFlush(meta, t.Immutable[0].LastSeq); err != nil {
+			return err
+		}
+		t.Immutable = t.Immutable[1:]
+	}
+	return nil
+}
+
+// publishFlush adds a freshly flushed SSTable, unless it is empty, and checkpoints
+// the CommitLog up to lastSeq. The manifest is saved first: until then the file is
+// not live, and on failure it is discarded so that the flush can be retried.
+// It assumes the lock is held.
+func (t *Tablet) publishFlush(meta *SSTableMetadata, lastSeq uint64) error {
+	meta.MaxSeq = lastSeq
+	empty := len(meta.reader.index) == 0
+	live := t.SSTables
+	if !empty {
+		live = append(live[:len(live):len(live)], *meta)
+	}
+	if err := t.saveManifestLocked(live, max(lastSeq, t.CommitLog.FlushedSeq())); err != nil {
+		meta.reader.retire()
+		return err
+	}
+
+	if empty {
+		meta.reader.retire()
+	} else {
+		t.SSTables = live
+		t.TriggerCompaction()
+	}
+	if lastSeq == 0 {
+		return nil
+	}
+	if err := t.CommitLog.MarkFlushed(lastSeq); err != nil {
+		return fmt.Errorf("failed to checkpoint commit log: %w", err)
+	}
+	return nil
+}
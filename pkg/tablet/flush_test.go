@@ -0,0 +1,83 @@
+package tablet
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestMemTableIsFlushedInTheBackground(t *testing.T) {
+	opts := testOptions()
+	opts.MemTableFlushThreshold = 4 << 10
+	tb := openTestTablet(t, t.TempDir(), opts)
+	key := func(i int) string { return fmt.Sprintf("row%04d", i) }
+	value := func(i int) string { return fmt.Sprintf("%0200d", i) }
+
+	// Reads of everything written so far stay correct while MemTables are frozen
+	// and flushed.
+	var written atomic.Int64
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			select {
+			case <-done:
+				return
+			default:
+			}
+			n := int(written.Load())
+			for i := 0; i < n; i++ {
+				v, err := tb.Read(key(i), "cf", "q")
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				if v == nil || string(v.Value) != value(i) {
+					t.Errorf("%s = %v during a flush, want %s", key(i), v, value(i))
+					return
+				}
+			}
+		}
+	}()
+
+	const rows = 500
+	for i := 0; i < rows; i++ {
+		mustMutate(t, tb, set(key(i), "q", 1, value(i)))
+		written.Add(1)
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		tb.mu.RLock()
+		frozen, sstables := len(tb.Immutable), len(tb.SSTables)
+		tb.mu.RUnlock()
+		if frozen == 0 && sstables > 0 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("%d frozen MemTables and %d SSTables, want every frozen MemTable flushed", frozen, sstables)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	close(done)
+	wg.Wait()
+
+	if size := tb.MemTable.Size(); size >= opts.MemTableFlushThreshold {
+		t.Errorf("active MemTable holds %d bytes, want it frozen at %d", size, opts.MemTableFlushThreshold)
+	}
+	if got := tb.CommitLog.FlushedSeq(); got == 0 {
+		t.Error("the commit log was not checkpointed by the flushes")
+	}
+
+	// Flushed and unflushed rows survive a restart.
+	tb = reopenTestTablet(t, tb)
+	for i := 0; i < rows; i++ {
+		if got := readValue(t, tb, key(i), "q"); got != value(i) {
+			t.Fatalf("%s = %q after reopening, want %s", key(i), got, value(i))
+		}
+	}
+}
@@ -0,0 +1,300 @@
+package tablet
+
+import (
+	"container/heap"
+	"sort"
+
+	"github.com/google/btree"
+)
+
+// RowIterator iterates over rows in increasing key order.
+//
+// Usage:
+//
+//	it := ...
+//	defer it.Close()
+//	for it.Next() {
+//		row := it.Row()
+//	}
+//	if err := it.Err(); err != nil { ... }
+type RowIterator interface {
+	// Next advances to the next row. It returns false when the iterator is
+	// exhausted, closed or has failed.
+	Next() bool
+	// Row returns the current row. The caller owns the returned row.
+	Row() *Row
+	// Err returns the first error encountered, if any.
+	Err() error
+	// Close releases resources. It is safe to call Close more than once.
+	Close() error
+}
+
+// PrefixSuccessor returns the smallest key greater than every key with the given prefix,
+// which makes it the exclusive end key for a prefix scan.
+// It returns "" (no upper bound) when no such key exists.
+func PrefixSuccessor(prefix string) string {
+	b := []byte(prefix)
+	for i := len(b) - 1; i >= 0; i-- {
+		if b[i] < 0xff {
+			b[i]++
+			return string(b[:i+1])
+		}
+	}
+	return ""
+}
+
+// memTableIteratorBatch is how many rows are copied out of the MemTable per lock acquisition.
+const memTableIteratorBatch = 64
+
+// memTableIterator iterates over a key range of a MemTable.
+// Rows are cloned in small batches so the MemTable lock is never held
+// while the caller processes a row.
+type memTableIterator struct {
+	m     *MemTable
+	next  string // smallest key not yet copied
+	end   string
+	batch []*Row
+	row   *Row
+	done  bool
+}
+
+func newMemTableIterator(m *MemTable, start, end string) *memTableIterator {
+	return &memTableIterator{m: m, next: start, end: end}
+}
+
+func (it *memTableIterator) fill() {
+	it.m.mu.RLock()
+	defer it.m.mu.RUnlock()
+
+	it.batch = it.batch[:0]
+	it.m.Tree.AscendGreaterOrEqual(RowItem{Row: &Row{Key: it.next}}, func(i btree.Item) bool {
+		row := i.(RowItem).Row
+		if it.end != "" && row.Key >= it.end {
+			return false
+		}
+		it.batch = append(it.batch, row.Clone())
+		return len(it.batch) < memTableIteratorBatch
+	})
+	if len(it.batch) < memTableIteratorBatch {
+		it.done = true
+	} else {
+		// Resume just after the last copied key.
+		it.next = it.batch[len(it.batch)-1].Key + "\x00"
+	}
+}
+
+func (it *memTableIterator) Next() bool {
+	if len(it.batch) == 0 {
+		if it.done {
+			it.row = nil
+			return false
+		}
+		it.fill()
+		if len(it.batch) == 0 {
+			it.row = nil
+			return false
+		}
+	}
+	it.row, it.batch = it.batch[0], it.batch[1:]
+	return true
+}
+
+func (it *memTableIterator) Row() *Row    { return it.row }
+func (it *memTableIterator) Err() error   { return nil }
+func (it *memTableIterator) Close() error { it.done, it.batch = true, nil; return nil }
+
+// sstableIterator iterates over a key range of an SSTable, reading one data block at a time.
+// It holds a reference on the reader until closed.
+type sstableIterator struct {
+	r          *SSTableReader
+	start, end string
+	blockIdx   int
+	rows       []*Row
+	row        *Row
+	err        error
+	done       bool
+}
+
+func newSSTableIterator(r *SSTableReader, start, end string) *sstableIterator {
+	// Skip straight to the first block that could hold start.
+	idx := sort.Search(len(r.index), func(i int) bool {
+		return r.index[i].LastKey >= start
+	})
+	r.ref()
+	return &sstableIterator{r: r, start: start, end: end, blockIdx: idx}
+}
+
+// loadBlock decodes the next data block into it.rows.
+func (it *sstableIterator) loadBlock() bool {
+	if it.blockIdx >= len(it.r.index) {
+		return false
+	}
+	data, err := it.r.readBlock(it.r.index[it.blockIdx])
+	if err != nil {
+		it.err = err
+		return false
+	}
+	it.blockIdx++
+
+	it.rows = it.rows[:0]
+	var decodeErr error
+	err = iterateBlock(data, func(k string, v []byte) bool {
+		if k < it.start {
+			return true
+		}
+		if it.end != "" && k >= it.end {
+			it.done = true
+			return false
+		}
+		row, err := decodeRow(k, v)
+		if err != nil {
+			decodeErr = err
+			return false
+		}
+		it.rows = append(it.rows, row)
+		return true
+	})
+	if err == nil {
+		err = decodeErr
+	}
+	if err != nil {
+		it.err = err
+		return false
+	}
+	return true
+}
+
+func (it *sstableIterator) Next() bool {
+	if it.r == nil {
+		return false
+	}
+	for len(it.rows) == 0 {
+		if it.done || it.err != nil || !it.loadBlock() {
+			it.row = nil
+			return false
+		}
+	}
+	it.row, it.rows = it.rows[0], it.rows[1:]
+	return true
+}
+
+func (it *sstableIterator) Row() *Row  { return it.row }
+func (it *sstableIterator) Err() error { return it.err }
+
+func (it *sstableIterator) Close() error {
+	if it.r == nil {
+		return nil
+	}
+	it.done, it.rows = true, nil
+	it.r.unref()
+	it.r = nil
+	return nil
+}
+
+// mergeIterator performs a k-way merge over several sorted sources.
+// Rows with the same key in more than one source are merged into a single row,
+// newest source first.
+type mergeIterator struct {
+	h      mergeHeap
+	row    *Row
+	err    error
+	closed bool
+	all    []RowIterator
+}
+
+// newMergeIterator merges the given iterators, which must be ordered newest
+// first. It takes ownership of them.
+func newMergeIterator(iters []RowIterator) *mergeIterator {
+	m := &mergeIterator{all: iters}
+	for i, it := range iters {
+		m.push(mergeSource{RowIterator: it, age: i})
+	}
+	heap.Init(&m.h)
+	return m
+}
+
+// push advances it and adds it to the heap if it has a row.
+func (m *mergeIterator) push(it mergeSource) {
+	if it.Next() {
+		m.h = append(m.h, it)
+		return
+	}
+	if err := it.Err(); err != nil && m.err == nil {
+		m.err = err
+	}
+}
+
+func (m *mergeIterator) Next() bool {
+	if m.closed || m.err != nil || len(m.h) == 0 {
+		m.row = nil
+		return false
+	}
+
+	// Pop every source positioned at the smallest key and merge their rows.
+	var row *Row
+	key := m.h[0].Row().Key
+	for len(m.h) > 0 && m.h[0].Row().Key == key {
+		it := m.h[0]
+		if row == nil {
+			row = it.Row()
+		} else {
+			mergeRows(row, it.Row())
+		}
+		if it.Next() {
+			heap.Fix(&m.h, 0)
+		} else {
+			heap.Pop(&m.h)
+			if err := it.Err(); err != nil {
+				m.err = err
+				m.row = nil
+				return false
+			}
+		}
+	}
+	m.row = row
+	return true
+}
+
+func (m *mergeIterator) Row() *Row  { return m.row }
+func (m *mergeIterator) Err() error { return m.err }
+
+func (m *mergeIterator) Close() error {
+	if m.closed {
+		return nil
+	}
+	m.closed = true
+	m.h = nil
+	var firstErr error
+	for _, it := range m.all {
+		if err := it.Close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// mergeSource is a source of a mergeIterator. age is its position among the
+// sources, which are ordered newest first.
+type mergeSource struct {
+	RowIterator
+	age int
+}
+
+// mergeHeap orders iterators by the key of their current row, then newest first,
+// so that rows with the same key are merged into the newest one.
+type mergeHeap []mergeSource
+
+func (h mergeHeap) Len() int { return len(h) }
+func (h mergeHeap) Less(i, j int) bool {
+	ki, kj := h[i].Row().Key, h[j].Row().Key
+	return ki < kj || (ki == kj && h[i].age < h[j].age)
+}
+func (h mergeHeap) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *mergeHeap) Push(x interface{}) { *h = append(*h, x.(mergeSource)) }
+func (h *mergeHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	it := old[n-1]
+	*h = old[:n-1]
+	return it
+}
@@ -0,0 +1,126 @@
+package tablet
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+const (
+	// manifestFileName is the file in a tablet directory that describes the tablet.
+	manifestFileName = "MANIFEST"
+	// manifestVersion is the manifest format written by this version.
+	manifestVersion = 1
+)
+
+// Manifest is the persistent description of a tablet: its key range and which
+// files in its directory hold live data. It is replaced atomically whenever the
+// set of live SSTables changes, so a crash never leaves a half-published flush
+// or compaction behind.
+type Manifest struct {
+	Version  int
+	TabletID string
+	StartKey string
+	EndKey   string
+
+	SSTables []ManifestSSTable
+	// FlushedSeq is the commit log checkpoint: every mutation up to it is in SSTables.
+	FlushedSeq uint64
+	// NextFileNum is the number of the next SSTable file, so numbers are never reused.
+	NextFileNum uint64
+}
+
+// ManifestSSTable describes a live SSTable. File is relative to the tablet directory.
+type ManifestSSTable struct {
+	File     string
+	Size     int64
+	FirstKey string
+	LastKey  string
+	MaxSeq   uint64
+}
+
+// loadManifest reads the manifest of a tablet directory. It returns nil if there is none.
+func loadManifest(dir string) (*Manifest, error) {
+	data, err := os.ReadFile(filepath.Join(dir, manifestFileName))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	m := &Manifest{}
+	if err := json.Unmarshal(data, m); err != nil {
+		return nil, fmt.Errorf("failed to parse manifest: %w", err)
+	}
+	if m.Version < 1 || m.Version > manifestVersion {
+		return nil, fmt.Errorf("unsupported manifest version %d", m.Version)
+	}
+	return m, nil
+}
+
+// save writes the manifest into a tablet directory, replacing the previous one atomically.
+func (m *Manifest) save(dir string) error {
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	path := filepath.Join(dir, manifestFileName)
+	tmp := path + ".tmp"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	// The manifest decides which files are live, so it must hit the disk before the rename.
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// OpenTablet reopens an existing tablet, taking its key range from the manifest.
+func OpenTablet(dir string, opts Options) (*Tablet, error) {
+	m, err := loadManifest(dir)
+	if err != nil {
+		return nil, err
+	}
+	if m == nil {
+		return nil, fmt.Errorf("no %s in %s", manifestFileName, dir)
+	}
+	return NewTabletWithOptions(m.StartKey, m.EndKey, dir, opts)
+}
+
+// saveManifestLocked persists sstables as the live set, with the commit log
+// checkpoint at flushedSeq. Callers update t.SSTables only once it succeeded.
+// It assumes the lock is held.
+func (t *Tablet) saveManifestLocked(sstables []SSTableMetadata, flushedSeq uint64) error {
+	m := &Manifest{
+		Version:     manifestVersion,
+		TabletID:    t.ID,
+		StartKey:    t.StartKey,
+		EndKey:      t.EndKey,
+		FlushedSeq:  flushedSeq,
+		NextFileNum: t.nextFileNum.Load(),
+	}
+	for _, sst := range sstables {
+		m.SSTables = append(m.SSTables, ManifestSSTable{
+			File:     filepath.Base(sst.Path),
+			Size:     sst.Size,
+			FirstKey: sst.FirstKey,
+			LastKey:  sst.LastKey,
+			MaxSeq:   sst.MaxSeq,
+		})
+	}
+	if err := m.save(t.Dir); err != nil {
+		return fmt.Errorf("failed to save manifest: %w", err)
+	}
+	return nil
+}
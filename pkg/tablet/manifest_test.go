@@ -0,0 +1,155 @@
+package tablet
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestManifestRecordsLiveSSTables(t *testing.T) {
+	dir := t.TempDir()
+	tb, err := NewTabletWithOptions("b", "m", dir, testOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tb.Close()
+	if err := tb.SetColumnFamily(ColumnFamily{Name: "cf"}); err != nil {
+		t.Fatal(err)
+	}
+	mustMutate(t, tb, set("c", "q", 1, "c1"))
+	mustMutate(t, tb, set("d", "q", 1, "d1"))
+	flushTestTablet(t, tb)
+	mustMutate(t, tb, set("k", "q", 1, "k1"))
+	flushTestTablet(t, tb)
+
+	m, err := loadManifest(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.Version != manifestVersion || m.TabletID != tb.ID || m.StartKey != "b" || m.EndKey != "m" {
+		t.Errorf("manifest describes tablet %q [%s, %s) version %d", m.TabletID, m.StartKey, m.EndKey, m.Version)
+	}
+	if m.FlushedSeq != 3 {
+		t.Errorf("FlushedSeq = %d, want 3", m.FlushedSeq)
+	}
+	if len(m.SSTables) != 2 {
+		t.Fatalf("manifest lists %d SSTables, want 2", len(m.SSTables))
+	}
+	first, second := m.SSTables[0], m.SSTables[1]
+	if first.FirstKey != "c" || first.LastKey != "d" || first.MaxSeq != 2 {
+		t.Errorf("first SSTable = %+v, want keys c to d up to seq 2", first)
+	}
+	if second.FirstKey != "k" || second.LastKey != "k" || second.MaxSeq != 3 {
+		t.Errorf("second SSTable = %+v, want key k at seq 3", second)
+	}
+	if m.NextFileNum <= 2 {
+		t.Errorf("NextFileNum = %d, want past both files", m.NextFileNum)
+	}
+}
+
+func TestOpenTabletFromManifest(t *testing.T) {
+	dir := t.TempDir()
+	tb, err := NewTabletWithOptions("b", "m", dir, testOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := tb.SetColumnFamily(ColumnFamily{Name: "cf"}); err != nil {
+		t.Fatal(err)
+	}
+	mustMutate(t, tb, set("c", "q", 1, "flushed"))
+	flushTestTablet(t, tb)
+	mustMutate(t, tb, set("d", "q", 1, "logged"))
+	id := tb.ID
+	tb.Close()
+
+	tb, err = OpenTablet(dir, testOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tb.Close()
+	if tb.ID != id || tb.StartKey != "b" || tb.EndKey != "m" {
+		t.Errorf("reopened tablet %q [%s, %s), want %q [b, m)", tb.ID, tb.StartKey, tb.EndKey, id)
+	}
+	if got := readValue(t, tb, "c", "q"); got != "flushed" {
+		t.Errorf("c = %q, want flushed", got)
+	}
+	if got := readValue(t, tb, "d", "q"); got != "logged" {
+		t.Errorf("d = %q, want logged", got)
+	}
+
+	if _, err := NewTabletWithOptions("a", "m", dir, testOptions()); err == nil {
+		t.Error("opened the tablet with a different key range")
+	}
+}
+
+func TestOpenTabletWithoutManifest(t *testing.T) {
+	if _, err := OpenTablet(t.TempDir(), testOptions()); err == nil {
+		t.Error("OpenTablet succeeded without a manifest")
+	}
+}
+
+func TestUnlistedSSTablesAreRemoved(t *testing.T) {
+	tb := openTestTablet(t, t.TempDir(), testOptions())
+	mustMutate(t, tb, set("k", "q", 1, "live"))
+	flushTestTablet(t, tb)
+	tb.Close()
+
+	// Outputs of a flush or compaction that crashed before it was published.
+	row := NewRow("k")
+	row.Set("cf", "q", 2, []byte("unpublished"))
+	stray := writeTestSSTable(t, []*Row{row}, SSTableOptions{})
+	data, err := os.ReadFile(stray)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"999999.sst", "999998.sst.tmp"} {
+		if err := os.WriteFile(filepath.Join(tb.Dir, name), data, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tb = openTestTablet(t, tb.Dir, tb.Options)
+	if got := readValue(t, tb, "k", "q"); got != "live" {
+		t.Errorf("k = %q, want live", got)
+	}
+	for _, name := range []string{"999999.sst", "999998.sst.tmp"} {
+		if _, err := os.Stat(filepath.Join(tb.Dir, name)); !os.IsNotExist(err) {
+			t.Errorf("%s was not removed: %v", name, err)
+		}
+	}
+	// File numbers are never reused, even those of removed files.
+	if n := tb.nextFileNum.Load(); n <= 999999 {
+		t.Errorf("next file number = %d, want past 999999", n)
+	}
+}
+
+func TestManifestCheckpointAheadOfLog(t *testing.T) {
+	tb := openTestTablet(t, t.TempDir(), testOptions())
+	mustMutate(t, tb, set("k", "q", 1, "v"))
+	flushTestTablet(t, tb)
+	tb.Close()
+	// A crash between saving the manifest and checkpointing the log.
+	if err := writeWALCheckpoint(tb.Dir, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	tb = openTestTablet(t, tb.Dir, tb.Options)
+	if tb.MemTable.Get("k") != nil {
+		t.Error("mutation already in an SSTable was replayed")
+	}
+	if got := tb.CommitLog.FlushedSeq(); got != 1 {
+		t.Errorf("log checkpoint = %d, want the manifest's 1", got)
+	}
+}
+
+func TestUnsupportedManifestVersion(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, manifestFileName), []byte(`{"Version": 99}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	_, err := OpenTablet(dir, testOptions())
+	if err == nil || !strings.Contains(err.Error(), "unsupported manifest version") {
+		t.Errorf("OpenTablet = %v, want an unsupported version error", err)
+	}
+}
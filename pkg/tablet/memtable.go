@@ -12,6 +12,10 @@ type MemTable struct {
 	mu        sync.RWMutex
 	Tree      *btree.BTree
 	SizeBytes int64
+
+	// LastSeq is the CommitLog sequence number of the last mutation applied.
+	// Once the MemTable is flushed, the log is no longer needed up to this point.
+	LastSeq uint64
 }
 
 // NewMemTable creates a new MemTable.
@@ -58,6 +62,13 @@ func (m *MemTable) Apply(mutation *RowMutation) error {
 	return row.Apply(mutation)
 }
 
+// Size returns the estimated size of the MemTable in bytes.
+func (m *MemTable) Size() int64 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.SizeBytes
+}
+
 func estimateMutationSize(m *RowMutation) int64 {
 	var size int64
 	for _, op := range m.Ops {
@@ -1,6 +1,7 @@
 package tablet
 
 import (
+	"errors"
 	"fmt"
 	"sort"
 	"sync"
@@ -13,11 +14,30 @@ type CellVersion struct {
 	Value     []byte
 }
 
+// TimestampRange is a half-open range of cell timestamps [Start, End).
+// An End of zero means the range has no upper bound.
+type TimestampRange struct {
+	Start int64
+	End   int64
+}
+
+// Contains reports whether ts lies in the range.
+func (tr TimestampRange) Contains(ts int64) bool {
+	return ts >= tr.Start && (tr.End == 0 || ts < tr.End)
+}
+
 // Column represents a column in a row, containing multiple versions of data.
 type Column struct {
 	Family   string
 	Qualifier string
 	Versions []CellVersion
+
+	// Tombstones are deleted timestamp ranges of this column.
+	// A delete removes the versions the row holds when it is applied; its
+	// tombstone is kept (and persisted in SSTables) so that it also shadows the
+	// versions held in older MemTables and SSTables, until a major compaction
+	// drops it. Versions written later are never shadowed.
+	Tombstones []TimestampRange `json:",omitempty"`
 }
 
 // NewColumn creates a new column.
@@ -29,12 +49,13 @@ func NewColumn(family, qualifier string) *Column {
 	}
 }
 
-// Insert adds a new version to the column.
+// Insert adds a new version to the column, replacing the version with the same
+// timestamp, if any.
 // It maintains the order of versions by Sort Order: timestamp descending (latest first).
 func (c *Column) Insert(timestamp int64, value []byte) {
 	// If timestamp is 0, use current time 
 	if timestamp == 0 {
-		timestamp = time.Now().UnixNano()
+		timestamp = nowTimestamp()
 	}
 
 	newVer := CellVersion{
@@ -49,12 +70,50 @@ func (c *Column) Insert(timestamp int64, value []byte) {
 	idx := sort.Search(len(c.Versions), func(i int) bool {
 		return c.Versions[i].Timestamp <= timestamp
 	})
+	if idx < len(c.Versions) && c.Versions[idx].Timestamp == timestamp {
+		c.Versions[idx] = newVer
+		return
+	}
 
 	c.Versions = append(c.Versions, CellVersion{})
 	copy(c.Versions[idx+1:], c.Versions[idx:])
 	c.Versions[idx] = newVer
 }
 
+// deleted reports whether a column tombstone covers ts.
+func (c *Column) deleted(ts int64) bool {
+	for _, tr := range c.Tombstones {
+		if tr.Contains(ts) {
+			return true
+		}
+	}
+	return false
+}
+
+// removeVersions removes the versions for which covered reports true.
+func (c *Column) removeVersions(covered func(ts int64) bool) {
+	kept := c.Versions[:0]
+	for _, v := range c.Versions {
+		if !covered(v.Timestamp) {
+			kept = append(kept, v)
+		}
+	}
+	c.Versions = kept
+}
+
+// addTombstone records a deleted range, dropping existing ranges it fully covers.
+func (c *Column) addTombstone(tr TimestampRange) {
+	kept := c.Tombstones[:0]
+	for _, old := range c.Tombstones {
+		coveredAbove := tr.End == 0 || (old.End != 0 && old.End <= tr.End)
+		if old.Start >= tr.Start && coveredAbove {
+			continue
+		}
+		kept = append(kept, old)
+	}
+	c.Tombstones = append(kept, tr)
+}
+
 // GetLatest returns the latest version of the cell data.
 func (c *Column) GetLatest() *CellVersion {
 	if len(c.Versions) == 0 {
@@ -68,37 +127,125 @@ type Row struct {
 	mu      sync.RWMutex
 	Key     string
 	Columns map[string]*Column // Key is "Family:Qualifier"
+
+	// DeletedAt is a whole-row tombstone: every cell with a timestamp <= DeletedAt
+	// written before it is deleted. Zero means the row has no row tombstone.
+	// Like column tombstones, it only shadows older sources.
+	DeletedAt int64 `json:",omitempty"`
+	// FamilyDeletedAt holds column family tombstones: every cell of the family
+	// with a timestamp <= the recorded timestamp written before it is deleted.
+	FamilyDeletedAt map[string]int64 `json:",omitempty"`
 }
 
 // NewRow creates a new row.
 func NewRow(key string) *Row {
 	return &Row{
-		Key:     key,
-		Columns: make(map[string]*Column),
+		Key:             key,
+		Columns:         make(map[string]*Column),
+		FamilyDeletedAt: make(map[string]int64),
+	}
+}
+
+// Clone returns a deep copy of the row.
+// The copy can be read and modified without holding the original's lock.
+func (r *Row) Clone() *Row {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	c := NewRow(r.Key)
+	c.DeletedAt = r.DeletedAt
+	for family, ts := range r.FamilyDeletedAt {
+		c.FamilyDeletedAt[family] = ts
+	}
+	for colKey, col := range r.Columns {
+		versions := make([]CellVersion, len(col.Versions))
+		copy(versions, col.Versions)
+		var tombstones []TimestampRange
+		if len(col.Tombstones) > 0 {
+			tombstones = make([]TimestampRange, len(col.Tombstones))
+			copy(tombstones, col.Tombstones)
+		}
+		c.Columns[colKey] = &Column{
+			Family:     col.Family,
+			Qualifier:  col.Qualifier,
+			Versions:   versions,
+			Tombstones: tombstones,
+		}
+	}
+	return c
+}
+
+// shadowed reports whether a row, column family or column tombstone of r
+// covers a cell of family:qualifier with timestamp ts, held by an older source.
+func (r *Row) shadowed(family, qualifier string, ts int64) bool {
+	if ts <= r.DeletedAt || ts <= r.FamilyDeletedAt[family] {
+		return true
+	}
+	col, ok := r.Columns[family+":"+qualifier]
+	return ok && col.deleted(ts)
+}
+
+// removeEmptyColumns removes the columns left with neither versions nor tombstones.
+func (r *Row) removeEmptyColumns() {
+	for colKey, col := range r.Columns {
+		if len(col.Versions) == 0 && len(col.Tombstones) == 0 {
+			delete(r.Columns, colKey)
+		}
 	}
 }
 
+// hasTombstones reports whether the row carries any delete marker.
+func (r *Row) hasTombstones() bool {
+	if r.DeletedAt != 0 || len(r.FamilyDeletedAt) > 0 {
+		return true
+	}
+	for _, col := range r.Columns {
+		if len(col.Tombstones) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// dropTombstones discards the row's tombstones. This is only safe when no older
+// data that they could shadow exists anywhere, e.g. during a major compaction,
+// or once every source has been merged into the row.
+func (r *Row) dropTombstones() {
+	r.DeletedAt = 0
+	r.FamilyDeletedAt = make(map[string]int64)
+	for colKey, col := range r.Columns {
+		col.Tombstones = nil
+		if len(col.Versions) == 0 {
+			delete(r.Columns, colKey)
+		}
+	}
+}
+
+// IsEmpty reports whether the row has no live cells.
+// A row that only holds tombstones is empty.
+func (r *Row) IsEmpty() bool {
+	for _, col := range r.Columns {
+		if len(col.Versions) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
 // Set adds a value to a specific column family and qualifier.
 func (r *Row) Set(family, qualifier string, timestamp int64, value []byte) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 	
-	colKey := family + ":" + qualifier
-	col, exists := r.Columns[colKey]
-	if !exists {
-		col = NewColumn(family, qualifier)
-		r.Columns[colKey] = col
-	}
-	col.Insert(timestamp, value)
+	r.setInternal(family, qualifier, timestamp, value)
 }
 
-// DeleteColumn deletes all data for a specific column.
+// DeleteColumn deletes all data for a specific column written up to now.
 func (r *Row) DeleteColumn(family, qualifier string) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
-	colKey := family + ":" + qualifier
-	delete(r.Columns, colKey)
+	r.deleteInternal(family, qualifier, TimestampRange{End: nowTimestamp() + 1})
 }
 
 // Get returns the latest value for a specific column.
@@ -119,7 +266,15 @@ type MutationType int
 
 const (
 	MutationSet MutationType = iota
+	// MutationDelete deletes the cells of a column with a timestamp <= Timestamp.
 	MutationDelete
+	// MutationDeleteTimeRange deletes the cells of a column in [Timestamp, EndTimestamp).
+	// An EndTimestamp of zero deletes up to the time the mutation is applied.
+	MutationDeleteTimeRange
+	// MutationDeleteFamily deletes the cells of a column family with a timestamp <= Timestamp.
+	MutationDeleteFamily
+	// MutationDeleteRow deletes every cell of the row with a timestamp <= Timestamp.
+	MutationDeleteRow
 )
 
 // MutationOperation represents a single operation within a mutation.
@@ -129,6 +284,10 @@ type MutationOperation struct {
 	Qualifier string
 	Timestamp int64
 	Value     []byte
+
+	// EndTimestamp is the exclusive end of a MutationDeleteTimeRange. Zero means
+	// up to the time the mutation is applied.
+	EndTimestamp int64
 }
 
 // RowMutation represents a set of operations to be applied atomically to a row.
@@ -157,6 +316,7 @@ func (rm *RowMutation) AddSet(family, qualifier string, timestamp int64, value [
 }
 
 // AddDelete adds a delete operation to the mutation.
+// It deletes every version of the column written before the mutation is applied.
 func (rm *RowMutation) AddDelete(family, qualifier string) {
 	rm.Ops = append(rm.Ops, MutationOperation{
 		Type:      MutationDelete,
@@ -165,12 +325,97 @@ func (rm *RowMutation) AddDelete(family, qualifier string) {
 	})
 }
 
+// AddDeleteTimeRange deletes the versions of a column with timestamps in [start, end).
+// An end of zero deletes up to the time the mutation is applied.
+func (rm *RowMutation) AddDeleteTimeRange(family, qualifier string, start, end int64) {
+	rm.Ops = append(rm.Ops, MutationOperation{
+		Type:         MutationDeleteTimeRange,
+		Family:       family,
+		Qualifier:    qualifier,
+		Timestamp:    start,
+		EndTimestamp: end,
+	})
+}
+
+// AddDeleteFamily deletes every column of a family written before the mutation is applied.
+func (rm *RowMutation) AddDeleteFamily(family string) {
+	rm.Ops = append(rm.Ops, MutationOperation{
+		Type:   MutationDeleteFamily,
+		Family: family,
+	})
+}
+
+// AddDeleteRow deletes every cell of the row written before the mutation is applied.
+func (rm *RowMutation) AddDeleteRow() {
+	rm.Ops = append(rm.Ops, MutationOperation{
+		Type: MutationDeleteRow,
+	})
+}
+
+// timestampGranularity is the granularity of server-assigned timestamps: whole
+// microseconds, like the Bigtable API, so that cells read over gRPC can be
+// overwritten or deleted exactly.
+const timestampGranularity = int64(time.Microsecond)
+
+// nowTimestamp returns the current time as a server-assigned timestamp.
+func nowTimestamp() int64 {
+	now := time.Now().UnixNano()
+	return now - now%timestampGranularity
+}
+
+// assignTimestamps replaces zero timestamps with now, and closes open time
+// ranges just after now (or after their start, if it is later).
+// This must happen before the mutation is logged, so that replaying the
+// CommitLog reproduces exactly the same cells and tombstones.
+func (rm *RowMutation) assignTimestamps(now int64) {
+	for i := range rm.Ops {
+		op := &rm.Ops[i]
+		switch {
+		case op.Type == MutationDeleteTimeRange:
+			if op.EndTimestamp == 0 {
+				op.EndTimestamp = max(now, op.Timestamp) + 1
+			}
+		case op.Timestamp == 0:
+			op.Timestamp = now
+		}
+	}
+}
+
+// ErrInvalidMutation is returned for mutations with operations of unknown types
+// or malformed time ranges, and for read-modify-writes that cannot apply as sent.
+var ErrInvalidMutation = errors.New("invalid mutation")
+
+// Validate rejects operations of unknown types and malformed time ranges.
+// Mutations must pass it before they are logged, so that replaying the
+// CommitLog never fails on a bad request.
+func (rm *RowMutation) Validate() error {
+	for _, op := range rm.Ops {
+		switch op.Type {
+		case MutationSet, MutationDelete, MutationDeleteFamily, MutationDeleteRow:
+		case MutationDeleteTimeRange:
+			if op.Timestamp < 0 || op.EndTimestamp < 0 {
+				return fmt.Errorf("%w: time range [%d, %d): timestamps must not be negative", ErrInvalidMutation, op.Timestamp, op.EndTimestamp)
+			}
+			if op.EndTimestamp != 0 && op.EndTimestamp <= op.Timestamp {
+				return fmt.Errorf("%w: time range [%d, %d): end must be after start", ErrInvalidMutation, op.Timestamp, op.EndTimestamp)
+			}
+		default:
+			return fmt.Errorf("%w: unknown mutation type %d", ErrInvalidMutation, op.Type)
+		}
+	}
+	return nil
+}
+
 // Apply applies a RowMutation to the row.
 // It ensures that the mutation is applied to the correct row and executes all operations.
+// An invalid mutation is rejected as a whole, before any operation is applied.
 func (r *Row) Apply(m *RowMutation) error {
 	if r.Key != m.RowKey {
 		return fmt.Errorf("mutation row key %s does not match row key %s", m.RowKey, r.Key)
 	}
+	if err := m.Validate(); err != nil {
+		return err
+	}
 
 	// Lock the row for the entire duration of the mutation batch to ensure atomicity.
 	r.mu.Lock()
@@ -184,14 +429,41 @@ func (r *Row) Apply(m *RowMutation) error {
 			// Ideally call unlocked version.
 			r.setInternal(op.Family, op.Qualifier, op.Timestamp, op.Value)
 		case MutationDelete:
-			r.deleteInternal(op.Family, op.Qualifier)
+			r.deleteInternal(op.Family, op.Qualifier, TimestampRange{End: op.Timestamp + 1})
+		case MutationDeleteTimeRange:
+			r.deleteInternal(op.Family, op.Qualifier, TimestampRange{Start: op.Timestamp, End: op.EndTimestamp})
+		case MutationDeleteFamily:
+			if op.Timestamp > r.FamilyDeletedAt[op.Family] {
+				r.FamilyDeletedAt[op.Family] = op.Timestamp
+			}
+			for _, col := range r.Columns {
+				if col.Family == op.Family {
+					col.removeVersions(func(ts int64) bool { return ts <= op.Timestamp })
+				}
+			}
+			r.removeEmptyColumns()
+		case MutationDeleteRow:
+			if op.Timestamp > r.DeletedAt {
+				r.DeletedAt = op.Timestamp
+			}
+			for _, col := range r.Columns {
+				col.removeVersions(func(ts int64) bool { return ts <= op.Timestamp })
+			}
+			r.removeEmptyColumns()
+		default:
+			return fmt.Errorf("unknown mutation type %d", op.Type)
 		}
 	}
 	return nil
 }
 
 // setInternal matches Set but assumes lock is held.
+// The cell is kept even if an earlier delete covers its timestamp: deletes
+// only remove the cells that exist when they are applied.
 func (r *Row) setInternal(family, qualifier string, timestamp int64, value []byte) {
+	if timestamp == 0 {
+		timestamp = nowTimestamp()
+	}
 	colKey := family + ":" + qualifier
 	col, exists := r.Columns[colKey]
 	if !exists {
@@ -201,8 +473,15 @@ func (r *Row) setInternal(family, qualifier string, timestamp int64, value []byt
 	col.Insert(timestamp, value)
 }
 
-// deleteInternal matches DeleteColumn but assumes lock is held.
-func (r *Row) deleteInternal(family, qualifier string) {
+// deleteInternal records a column tombstone and drops the versions it covers.
+// It assumes lock is held.
+func (r *Row) deleteInternal(family, qualifier string, tr TimestampRange) {
 	colKey := family + ":" + qualifier
-	delete(r.Columns, colKey)
+	col, exists := r.Columns[colKey]
+	if !exists {
+		col = NewColumn(family, qualifier)
+		r.Columns[colKey] = col
+	}
+	col.addTombstone(tr)
+	col.removeVersions(tr.Contains)
 }
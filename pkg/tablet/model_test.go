@@ -0,0 +1,130 @@
+package tablet
+
+import (
+	"reflect"
+	"testing"
+)
+
+// step is one action of a delete test: a mutation, or a flush when nil.
+type step *RowMutation
+
+func deleteRange(start, end int64) step {
+	m := NewRowMutation("k")
+	m.AddDeleteTimeRange("cf", "q", start, end)
+	return m
+}
+
+func deleteRow() step {
+	m := NewRowMutation("k")
+	m.AddDeleteRow()
+	return m
+}
+
+func deleteFamily() step {
+	m := NewRowMutation("k")
+	m.AddDeleteFamily("cf")
+	return m
+}
+
+func deleteColumn() step {
+	m := NewRowMutation("k")
+	m.AddDelete("cf", "q")
+	return m
+}
+
+// flush is the step that writes the MemTable to an SSTable.
+var flush step
+
+func TestDeletes(t *testing.T) {
+	tests := []struct {
+		name  string
+		steps []step
+		want  []int64 // timestamps of the versions of k's cf:q, newest first
+	}{
+		{"set then delete column", []step{set("k", "q", 5, "v"), deleteColumn()}, nil},
+		{"delete column then set", []step{deleteColumn(), set("k", "q", 5, "v")}, []int64{5}},
+		{"flushed set then delete column", []step{set("k", "q", 5, "v"), flush, deleteColumn()}, nil},
+		{"open range then set", []step{deleteRange(0, 0), set("k", "q", 5, "v")}, []int64{5}},
+		{"open range then flushed set", []step{deleteRange(0, 0), flush, set("k", "q", 5, "v")}, []int64{5}},
+		{"set then open range", []step{set("k", "q", 5, "v"), set("k", "q", 9, "v"), flush, deleteRange(6, 0)}, []int64{5}},
+		{"closed range", []step{set("k", "q", 4, "v"), set("k", "q", 5, "v"), set("k", "q", 6, "v"), flush, deleteRange(5, 6)}, []int64{6, 4}},
+		{"delete row then older set", []step{set("k", "q", 5, "v"), deleteRow(), set("k", "q", 3, "v")}, []int64{3}},
+		{"flushed delete row then older set", []step{set("k", "q", 5, "v"), flush, deleteRow(), flush, set("k", "q", 3, "v")}, []int64{3}},
+		{"delete family across sources", []step{set("k", "q", 5, "v"), flush, set("k", "q", 6, "v"), deleteFamily()}, nil},
+		{"delete family then set", []step{set("k", "q", 5, "v"), flush, deleteFamily(), flush, set("k", "q", 4, "v")}, []int64{4}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tb := openTestTablet(t, t.TempDir(), testOptions())
+			for _, s := range tt.steps {
+				if s == nil {
+					flushTestTablet(t, tb)
+				} else {
+					mustMutate(t, tb, s)
+				}
+			}
+
+			check := func(when string) {
+				t.Helper()
+				versions, err := tb.ReadVersions("k", "cf", "q", ReadOptions{})
+				if err != nil {
+					t.Fatal(err)
+				}
+				var got []int64
+				for _, v := range versions {
+					got = append(got, v.Timestamp)
+				}
+				if !reflect.DeepEqual(got, tt.want) {
+					t.Errorf("%s: versions = %v, want %v", when, got, tt.want)
+				}
+				if v := scanValues(t, tb); (v["k"] != "") != (len(tt.want) > 0) {
+					t.Errorf("%s: Scan = %v, want k present: %v", when, v, len(tt.want) > 0)
+				}
+			}
+			check("as written")
+			tb = reopenTestTablet(t, tb)
+			check("after replaying the log")
+			flushTestTablet(t, tb)
+			check("after flushing")
+			if n := len(tb.SSTables); n > 1 {
+				compactTestTablet(t, tb, 1, n)
+				check("after a minor compaction")
+				compactTestTablet(t, tb, 0, len(tb.SSTables))
+				check("after a major compaction")
+			}
+		})
+	}
+}
+
+func TestOpenRangeIsClosedAtApplyTime(t *testing.T) {
+	m := deleteRange(3, 0)
+	(*RowMutation)(m).assignTimestamps(100)
+	if got := m.Ops[0].EndTimestamp; got != 101 {
+		t.Errorf("EndTimestamp = %d, want 101", got)
+	}
+	m = deleteRange(200, 0)
+	(*RowMutation)(m).assignTimestamps(100)
+	if got := m.Ops[0].EndTimestamp; got != 201 {
+		t.Errorf("EndTimestamp of a future range = %d, want 201", got)
+	}
+}
+
+func TestMergeRowsTombstonesOnlyShadowOlderSources(t *testing.T) {
+	newer := NewRow("k")
+	newer.DeletedAt = 10
+	newer.Set("cf", "q", 5, []byte("written after the delete"))
+	older := NewRow("k")
+	older.Set("cf", "q", 7, []byte("deleted"))
+	older.Set("cf", "q", 11, []byte("kept"))
+	older.Set("cf", "q", 5, []byte("overwritten"))
+
+	mergeRows(newer, older)
+	var got []string
+	for _, v := range newer.Columns["cf:q"].Versions {
+		got = append(got, string(v.Value))
+	}
+	want := []string{"kept", "written after the delete"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("merged versions = %q, want %q", got, want)
+	}
+}
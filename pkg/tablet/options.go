@@ -0,0 +1,61 @@
+package tablet
+
+import "time"
+
+// Options configures a Tablet.
+type Options struct {
+	// SSTable controls the layout of SSTables written by flushes and compactions.
+	SSTable SSTableOptions
+
+	// MemTableFlushThreshold is the MemTable size in bytes at which it is frozen
+	// and flushed to an SSTable in the background. Zero disables automatic flushes.
+	MemTableFlushThreshold int64
+
+	// CompactionStrategy picks SSTables for background compaction. Nil disables it.
+	CompactionStrategy CompactionStrategy
+	// CompactionLimiter bounds concurrent compactions and may be shared between tablets.
+	// If nil, each tablet runs at most one compaction at a time.
+	CompactionLimiter *CompactionLimiter
+	// CompactionInterval is how often the tablet looks for compaction work,
+	// in addition to whenever an SSTable is added.
+	CompactionInterval time.Duration
+
+	// CommitLog controls how the commit log syncs, e.g. group commit.
+	CommitLog CommitLogOptions
+	// Durability is the durability of Mutate. MutateWithOptions overrides it per request.
+	Durability Durability
+}
+
+// MutateOptions configures a single mutation.
+type MutateOptions struct {
+	Durability Durability
+}
+
+// DefaultMemTableFlushThreshold is the MemTable size that triggers a flush by default.
+const DefaultMemTableFlushThreshold = 64 << 20
+
+// Defaults for group commit, used once CommitLogOptions.GroupCommit is turned on.
+const (
+	DefaultGroupCommitMaxDelay = 2 * time.Millisecond
+	DefaultGroupCommitMaxSize  = 256
+)
+
+// DefaultOptions returns the options used by NewTablet.
+func DefaultOptions() Options {
+	return Options{
+		SSTable: SSTableOptions{
+			BlockSize: DefaultBlockSize,
+			Bloom: BloomFilterOptions{
+				FalsePositiveRate: DefaultBloomFalsePositiveRate,
+			},
+		},
+		MemTableFlushThreshold: DefaultMemTableFlushThreshold,
+		CompactionStrategy:     NewSizeTieredStrategy(),
+		CompactionInterval:     time.Minute,
+		CommitLog: CommitLogOptions{
+			MaxBatchDelay: DefaultGroupCommitMaxDelay,
+			MaxBatchSize:  DefaultGroupCommitMaxSize,
+		},
+		Durability: DurabilitySync,
+	}
+}
@@ -0,0 +1,168 @@
+package tablet
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ReadOptions selects which versions of a column a read returns.
+// The zero value returns every live version.
+type ReadOptions struct {
+	// TimeRange restricts versions to timestamps in [Start, End).
+	TimeRange TimestampRange
+	// MaxVersions caps the versions returned per column, newest first. Zero means no limit.
+	MaxVersions int
+	// AsOf reads the column as it was at that timestamp: newer versions are
+	// invisible. Zero means now.
+	// Deleted data is gone for good, so tombstones apply regardless of AsOf.
+	AsOf int64
+}
+
+// visible reports whether a version with timestamp ts passes the time filters.
+func (o ReadOptions) visible(ts int64) bool {
+	if o.AsOf != 0 && ts > o.AsOf {
+		return false
+	}
+	return o.TimeRange.Contains(ts)
+}
+
+// filter returns the versions, newest first, that the options select.
+func (o ReadOptions) filter(versions []CellVersion) []CellVersion {
+	var out []CellVersion
+	for _, v := range versions {
+		if o.MaxVersions > 0 && len(out) >= o.MaxVersions {
+			break
+		}
+		if o.visible(v.Timestamp) {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// ReadVersions returns the live versions of a column selected by opts, newest first,
+// merged across the MemTables and all SSTables.
+func (t *Tablet) ReadVersions(rowKey, family, qualifier string, opts ReadOptions) ([]CellVersion, error) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	if t.closed {
+		return nil, ErrTabletClosed
+	}
+	if !t.InRange(rowKey) {
+		return nil, fmt.Errorf("%w: '%s' not in [%s, %s)", ErrKeyOutOfRange, rowKey, t.StartKey, t.EndKey)
+	}
+
+	merged, err := t.mergeRowLocked(rowKey, func(r *SSTableReader) bool {
+		return r.MayContainColumn(rowKey, family, qualifier)
+	})
+	if err != nil {
+		return nil, err
+	}
+	col, ok := merged.Columns[family+":"+qualifier]
+	if !ok {
+		return nil, nil
+	}
+	return opts.filter(col.Versions), nil
+}
+
+// ReadFilter selects the parts of a row that ReadRow returns.
+// The zero value selects the whole row with every live version.
+type ReadFilter struct {
+	// Families selects whole column families.
+	Families []string
+	// Columns selects single columns as "family:qualifier".
+	Columns []string
+	// Versions filters the versions of every selected column.
+	Versions ReadOptions
+	// Filter, if set, is applied last to the selected cells.
+	Filter *RowFilter
+}
+
+// selectsAll reports whether the filter selects every column.
+func (f ReadFilter) selectsAll() bool {
+	return len(f.Families) == 0 && len(f.Columns) == 0
+}
+
+// selects reports whether the column is selected.
+func (f ReadFilter) selects(col *Column) bool {
+	if f.selectsAll() {
+		return true
+	}
+	for _, family := range f.Families {
+		if col.Family == family {
+			return true
+		}
+	}
+	colKey := col.Family + ":" + col.Qualifier
+	for _, c := range f.Columns {
+		if c == colKey {
+			return true
+		}
+	}
+	return false
+}
+
+// mayContain reports whether an SSTable may hold data the filter selects.
+// Column Bloom filters only help when no whole family is selected.
+func (f ReadFilter) mayContain(r *SSTableReader, rowKey string) bool {
+	if len(f.Families) > 0 || len(f.Columns) == 0 {
+		return r.MayContain(rowKey)
+	}
+	for _, c := range f.Columns {
+		family, qualifier, _ := strings.Cut(c, ":")
+		if r.MayContainColumn(rowKey, family, qualifier) {
+			return true
+		}
+	}
+	return false
+}
+
+// ReadRow returns the live cells of a row selected by filter, merged across
+// the MemTables and all SSTables, in a single pass over the sources.
+// It returns nil if nothing is selected.
+func (t *Tablet) ReadRow(rowKey string, filter ReadFilter) (*Row, error) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	if t.closed {
+		return nil, ErrTabletClosed
+	}
+	if !t.InRange(rowKey) {
+		return nil, fmt.Errorf("%w: '%s' not in [%s, %s)", ErrKeyOutOfRange, rowKey, t.StartKey, t.EndKey)
+	}
+	var rowFilter filterFunc
+	if filter.Filter != nil {
+		var err error
+		if rowFilter, err = compileRowFilter(filter.Filter); err != nil {
+			return nil, fmt.Errorf("invalid row filter: %w", err)
+		}
+	}
+
+	merged, err := t.mergeRowLocked(rowKey, func(r *SSTableReader) bool {
+		return filter.mayContain(r, rowKey)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Tombstones have been applied; the caller only gets the cells.
+	out := NewRow(rowKey)
+	for colKey, col := range merged.Columns {
+		if !filter.selects(col) {
+			continue
+		}
+		versions := filter.Versions.filter(col.Versions)
+		if len(versions) == 0 {
+			continue
+		}
+		out.Columns[colKey] = &Column{Family: col.Family, Qualifier: col.Qualifier, Versions: versions}
+	}
+	if rowFilter != nil {
+		out.applyFilter(rowFilter)
+	}
+	if len(out.Columns) == 0 {
+		return nil, nil
+	}
+	return out, nil
+}
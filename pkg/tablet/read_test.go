@@ -0,0 +1,137 @@
+package tablet
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+// openReadTestTablet returns a tablet whose cf:q of row k has live versions
+// 50 and 40 in the MemTable, 30 in the newer SSTable and 10 in the older one.
+// Version 20 of the older SSTable is deleted by the newer one.
+func openReadTestTablet(t *testing.T) *Tablet {
+	t.Helper()
+	tb := openTestTablet(t, t.TempDir(), testOptions())
+	mustMutate(t, tb, set("k", "q", 10, "v10"))
+	mustMutate(t, tb, set("k", "q", 20, "v20"))
+	flushTestTablet(t, tb)
+	mustMutate(t, tb, set("k", "q", 30, "v30"))
+	del := NewRowMutation("k")
+	del.AddDeleteTimeRange("cf", "q", 20, 21)
+	mustMutate(t, tb, del)
+	flushTestTablet(t, tb)
+	mustMutate(t, tb, set("k", "q", 40, "v40"))
+	mustMutate(t, tb, set("k", "q", 50, "v50"))
+	return tb
+}
+
+func TestReadVersions(t *testing.T) {
+	tb := openReadTestTablet(t)
+	tests := []struct {
+		name string
+		opts ReadOptions
+		want []int64
+	}{
+		{"all", ReadOptions{}, []int64{50, 40, 30, 10}},
+		{"max versions", ReadOptions{MaxVersions: 2}, []int64{50, 40}},
+		{"max versions past the column", ReadOptions{MaxVersions: 9}, []int64{50, 40, 30, 10}},
+		{"time range", ReadOptions{TimeRange: TimestampRange{Start: 15, End: 45}}, []int64{40, 30}},
+		{"time range end is exclusive", ReadOptions{TimeRange: TimestampRange{Start: 10, End: 30}}, []int64{10}},
+		{"open time range", ReadOptions{TimeRange: TimestampRange{Start: 35}}, []int64{50, 40}},
+		{"as of", ReadOptions{AsOf: 35}, []int64{30, 10}},
+		{"as of is inclusive", ReadOptions{AsOf: 40}, []int64{40, 30, 10}},
+		{"as of with max versions", ReadOptions{AsOf: 45, MaxVersions: 1}, []int64{40}},
+		{"as of with time range", ReadOptions{AsOf: 40, TimeRange: TimestampRange{End: 40}}, []int64{30, 10}},
+		{"as of does not undelete", ReadOptions{AsOf: 25}, []int64{10}},
+		{"as of before every version", ReadOptions{AsOf: 5}, nil},
+		{"time range with max versions", ReadOptions{TimeRange: TimestampRange{End: 45}, MaxVersions: 2}, []int64{40, 30}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			versions, err := tb.ReadVersions("k", "cf", "q", tt.opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := versionTimestamps(versions); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ReadVersions = %v, want %v", got, tt.want)
+			}
+			for _, v := range versions {
+				if want := "v" + strconv.FormatInt(v.Timestamp, 10); string(v.Value) != want {
+					t.Errorf("version %d = %q, want %q", v.Timestamp, v.Value, want)
+				}
+			}
+		})
+	}
+
+	if versions, err := tb.ReadVersions("k", "cf", "missing", ReadOptions{}); err != nil || versions != nil {
+		t.Errorf("ReadVersions of a missing column = %v, %v, want nothing", versions, err)
+	}
+	if versions, err := tb.ReadVersions("k", "nope", "q", ReadOptions{}); err != nil || versions != nil {
+		t.Errorf("ReadVersions of an unknown family = %v, %v, want nothing", versions, err)
+	}
+}
+
+func TestReadRow(t *testing.T) {
+	tb := openTestTablet(t, t.TempDir(), testOptions())
+	if err := tb.SetColumnFamily(ColumnFamily{Name: "cf2"}); err != nil {
+		t.Fatal(err)
+	}
+	m := NewRowMutation("r")
+	m.AddSet("cf", "a", 1, []byte("a1"))
+	m.AddSet("cf", "b", 1, []byte("b1"))
+	m.AddSet("cf2", "x", 1, []byte("x1"))
+	mustMutate(t, tb, m)
+	mustMutate(t, tb, set("other", "a", 1, "other"))
+	flushTestTablet(t, tb)
+	m = NewRowMutation("r")
+	m.AddSet("cf", "a", 2, []byte("a2"))
+	m.AddDelete("cf", "b")
+	m.AddSet("cf2", "y", 3, []byte("y3"))
+	mustMutate(t, tb, m)
+
+	tests := []struct {
+		name   string
+		filter ReadFilter
+		want   []string
+	}{
+		{"whole row", ReadFilter{}, []string{"cf:a@2=a2", "cf:a@1=a1", "cf2:x@1=x1", "cf2:y@3=y3"}},
+		{"family", ReadFilter{Families: []string{"cf2"}}, []string{"cf2:x@1=x1", "cf2:y@3=y3"}},
+		{"column", ReadFilter{Columns: []string{"cf:a"}}, []string{"cf:a@2=a2", "cf:a@1=a1"}},
+		{"family and column", ReadFilter{Families: []string{"cf2"}, Columns: []string{"cf:a"}}, []string{"cf:a@2=a2", "cf:a@1=a1", "cf2:x@1=x1", "cf2:y@3=y3"}},
+		{"deleted column", ReadFilter{Columns: []string{"cf:b"}}, nil},
+		{"missing column", ReadFilter{Columns: []string{"cf2:z"}}, nil},
+		{"unknown family", ReadFilter{Families: []string{"nope"}}, nil},
+		{"max versions", ReadFilter{Versions: ReadOptions{MaxVersions: 1}}, []string{"cf:a@2=a2", "cf2:x@1=x1", "cf2:y@3=y3"}},
+		{"as of", ReadFilter{Versions: ReadOptions{AsOf: 1}}, []string{"cf:a@1=a1", "cf2:x@1=x1"}},
+		{"time range", ReadFilter{Families: []string{"cf"}, Versions: ReadOptions{TimeRange: TimestampRange{Start: 2}}}, []string{"cf:a@2=a2"}},
+		{"row filter after selection", ReadFilter{Families: []string{"cf"}, Filter: &RowFilter{StripValue: true}}, []string{"cf:a@2=", "cf:a@1="}},
+		{"row filter leaves nothing", ReadFilter{Filter: &RowFilter{BlockAll: true}}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			row, err := tb.ReadRow("r", tt.filter)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.want == nil {
+				if row != nil {
+					t.Errorf("ReadRow = %q, want nil", cellNames(rowFilterCells(row)))
+				}
+				return
+			}
+			if row == nil {
+				t.Fatalf("ReadRow = nil, want %q", tt.want)
+			}
+			if got := cellNames(rowFilterCells(row)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ReadRow = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	if row, err := tb.ReadRow("missing", ReadFilter{}); err != nil || row != nil {
+		t.Errorf("ReadRow of a missing row = %v, %v, want nil", row, err)
+	}
+	if _, err := tb.ReadRow("r", ReadFilter{Filter: &RowFilter{CellsPerRowLimit: -1}}); err == nil {
+		t.Error("ReadRow accepted an invalid row filter")
+	}
+}
@@ -0,0 +1,162 @@
+package tablet
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// ReadModifyWriteType defines the kind of read-modify-write rule.
+type ReadModifyWriteType int
+
+const (
+	// ReadModifyWriteIncrement adds IncrementAmount to a cell holding a
+	// big-endian int64. A missing cell counts as zero.
+	ReadModifyWriteIncrement ReadModifyWriteType = iota
+	// ReadModifyWriteAppend appends AppendValue to the cell's value.
+	// A missing cell counts as empty.
+	ReadModifyWriteAppend
+)
+
+// ReadModifyWriteRule modifies the latest value of one column.
+type ReadModifyWriteRule struct {
+	Type            ReadModifyWriteType
+	Family          string
+	Qualifier       string
+	IncrementAmount int64
+	AppendValue     []byte
+}
+
+// ReadModifyWriteRow is a list of rules applied atomically to one row, in order.
+// A rule sees the result of earlier rules on the same column.
+type ReadModifyWriteRow struct {
+	RowKey string
+	Rules  []ReadModifyWriteRule
+}
+
+// NewReadModifyWriteRow creates an empty read-modify-write of a row.
+func NewReadModifyWriteRow(rowKey string) *ReadModifyWriteRow {
+	return &ReadModifyWriteRow{RowKey: rowKey}
+}
+
+// AddIncrement adds delta to a big-endian int64 cell.
+func (rmw *ReadModifyWriteRow) AddIncrement(family, qualifier string, delta int64) {
+	rmw.Rules = append(rmw.Rules, ReadModifyWriteRule{
+		Type:            ReadModifyWriteIncrement,
+		Family:          family,
+		Qualifier:       qualifier,
+		IncrementAmount: delta,
+	})
+}
+
+// AddAppend appends value to a cell.
+func (rmw *ReadModifyWriteRow) AddAppend(family, qualifier string, value []byte) {
+	rmw.Rules = append(rmw.Rules, ReadModifyWriteRule{
+		Type:        ReadModifyWriteAppend,
+		Family:      family,
+		Qualifier:   qualifier,
+		AppendValue: value,
+	})
+}
+
+// ReadModifyWrite atomically applies the rules to the latest versions of the
+// row's cells, merged from the MemTables and all SSTables, and returns the new
+// cells. Each new value is written as a new version, newer than the one it was
+// computed from, and is logged as a plain Set so that replay is deterministic.
+func (t *Tablet) ReadModifyWrite(rmw *ReadModifyWriteRow) (*Row, error) {
+	if len(rmw.Rules) == 0 {
+		return nil, fmt.Errorf("%w: read-modify-write of row '%s' has no rules", ErrInvalidMutation, rmw.RowKey)
+	}
+	durability := t.Options.Durability
+
+	t.mu.Lock()
+	if t.closed {
+		t.mu.Unlock()
+		return nil, ErrTabletClosed
+	}
+	if !t.InRange(rmw.RowKey) {
+		t.mu.Unlock()
+		return nil, fmt.Errorf("%w: '%s' not in [%s, %s)", ErrKeyOutOfRange, rmw.RowKey, t.StartKey, t.EndKey)
+	}
+
+	// 1. Read the current row
+	current, err := t.mergeRowLocked(rmw.RowKey, func(r *SSTableReader) bool {
+		return r.MayContain(rmw.RowKey)
+	})
+	if err != nil {
+		t.mu.Unlock()
+		return nil, err
+	}
+
+	// 2. Compute the new cells
+	result, err := rmw.apply(current, nowTimestamp())
+	if err != nil {
+		t.mu.Unlock()
+		return nil, err
+	}
+
+	// 3. Write them as Sets
+	m := NewRowMutation(rmw.RowKey)
+	for _, col := range result.Columns {
+		v := col.Versions[0]
+		m.AddSet(col.Family, col.Qualifier, v.Timestamp, v.Value)
+	}
+	seq, err := t.mutateLocked(m, durability)
+	t.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := t.syncMutation(seq, durability); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// apply computes the cells the rules produce from the current row.
+// Every result column holds a single version.
+func (rmw *ReadModifyWriteRow) apply(current *Row, now int64) (*Row, error) {
+	result := NewRow(rmw.RowKey)
+	for _, rule := range rmw.Rules {
+		colKey := rule.Family + ":" + rule.Qualifier
+
+		// Start from an earlier rule's result, or else the latest stored version.
+		var latest *CellVersion
+		if col, ok := result.Columns[colKey]; ok {
+			latest = &col.Versions[0]
+		} else {
+			latest = current.Get(rule.Family, rule.Qualifier)
+		}
+
+		var value []byte
+		switch rule.Type {
+		case ReadModifyWriteIncrement:
+			var n int64
+			if latest != nil {
+				if len(latest.Value) != 8 {
+					return nil, fmt.Errorf("%w: cannot increment %s: value is %d bytes, not a 64-bit integer", ErrInvalidMutation, colKey, len(latest.Value))
+				}
+				n = int64(binary.BigEndian.Uint64(latest.Value))
+			}
+			value = binary.BigEndian.AppendUint64(nil, uint64(n+rule.IncrementAmount))
+		case ReadModifyWriteAppend:
+			if latest != nil {
+				value = append(value, latest.Value...)
+			}
+			value = append(value, rule.AppendValue...)
+		default:
+			return nil, fmt.Errorf("%w: unknown read-modify-write type %d", ErrInvalidMutation, rule.Type)
+		}
+
+		// The new version must become the latest even if the clock lags behind it.
+		ts := now
+		if latest != nil && latest.Timestamp >= ts {
+			ts = latest.Timestamp - latest.Timestamp%timestampGranularity + timestampGranularity
+		}
+		result.Columns[colKey] = &Column{
+			Family:    rule.Family,
+			Qualifier: rule.Qualifier,
+			Versions:  []CellVersion{{Timestamp: ts, Value: value}},
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,124 @@
+package tablet
+
+import (
+	"encoding/binary"
+	"errors"
+	"testing"
+)
+
+func int64Value(n int64) string {
+	return string(binary.BigEndian.AppendUint64(nil, uint64(n)))
+}
+
+func TestReadModifyWrite(t *testing.T) {
+	tests := []struct {
+		name    string
+		stored  map[string]string // Flushed cells of row k, by qualifier
+		rules   []ReadModifyWriteRule
+		want    map[string]string
+		wantErr error
+	}{
+		{
+			name:  "increment missing cell",
+			rules: []ReadModifyWriteRule{{Type: ReadModifyWriteIncrement, Family: "cf", Qualifier: "n", IncrementAmount: 5}},
+			want:  map[string]string{"n": int64Value(5)},
+		},
+		{
+			name:   "increment stored cell",
+			stored: map[string]string{"n": int64Value(10)},
+			rules:  []ReadModifyWriteRule{{Type: ReadModifyWriteIncrement, Family: "cf", Qualifier: "n", IncrementAmount: -13}},
+			want:   map[string]string{"n": int64Value(-3)},
+		},
+		{
+			name:    "increment non-8-byte value",
+			stored:  map[string]string{"n": "abc"},
+			rules:   []ReadModifyWriteRule{{Type: ReadModifyWriteIncrement, Family: "cf", Qualifier: "n", IncrementAmount: 1}},
+			wantErr: ErrInvalidMutation,
+		},
+		{
+			name:  "append to missing cell",
+			rules: []ReadModifyWriteRule{{Type: ReadModifyWriteAppend, Family: "cf", Qualifier: "s", AppendValue: []byte("ab")}},
+			want:  map[string]string{"s": "ab"},
+		},
+		{
+			name:   "append to stored cell",
+			stored: map[string]string{"s": "x"},
+			rules:  []ReadModifyWriteRule{{Type: ReadModifyWriteAppend, Family: "cf", Qualifier: "s", AppendValue: []byte("yz")}},
+			want:   map[string]string{"s": "xyz"},
+		},
+		{
+			name:   "several rules on the same cell",
+			stored: map[string]string{"n": int64Value(1), "s": "a"},
+			rules: []ReadModifyWriteRule{
+				{Type: ReadModifyWriteIncrement, Family: "cf", Qualifier: "n", IncrementAmount: 1},
+				{Type: ReadModifyWriteAppend, Family: "cf", Qualifier: "s", AppendValue: []byte("b")},
+				{Type: ReadModifyWriteIncrement, Family: "cf", Qualifier: "n", IncrementAmount: 2},
+				{Type: ReadModifyWriteAppend, Family: "cf", Qualifier: "s", AppendValue: []byte("c")},
+			},
+			want: map[string]string{"n": int64Value(4), "s": "abc"},
+		},
+		{
+			name:    "no rules",
+			wantErr: ErrInvalidMutation,
+		},
+		{
+			name:    "unknown rule type",
+			rules:   []ReadModifyWriteRule{{Type: 7, Family: "cf", Qualifier: "n"}},
+			wantErr: ErrInvalidMutation,
+		},
+		{
+			name:    "unknown family",
+			rules:   []ReadModifyWriteRule{{Type: ReadModifyWriteAppend, Family: "nope", Qualifier: "s", AppendValue: []byte("a")}},
+			wantErr: ErrUnknownColumnFamily,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tb := openTestTablet(t, t.TempDir(), testOptions())
+			for q, v := range tt.stored {
+				mustMutate(t, tb, set("k", q, 1000, v))
+			}
+			flushTestTablet(t, tb)
+
+			row, err := tb.ReadModifyWrite(&ReadModifyWriteRow{RowKey: "k", Rules: tt.rules})
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("ReadModifyWrite = %v, want %v", err, tt.wantErr)
+				}
+				for q, v := range tt.stored {
+					if got := readValue(t, tb, "k", q); got != v {
+						t.Errorf("%s = %q after a failed read-modify-write, want %q", q, got, v)
+					}
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(row.Columns) != len(tt.want) {
+				t.Errorf("ReadModifyWrite returned %d columns, want %d", len(row.Columns), len(tt.want))
+			}
+			for q, want := range tt.want {
+				v := row.Get("cf", q)
+				if v == nil || string(v.Value) != want {
+					t.Fatalf("returned %s = %v, want %q", q, v, want)
+				}
+				if v.Timestamp <= 1000 {
+					t.Errorf("returned %s at %d, want newer than the stored version", q, v.Timestamp)
+				}
+			}
+
+			// The logged Sets replay to the same cells.
+			tb = reopenTestTablet(t, tb)
+			for q, want := range tt.want {
+				v, err := tb.Read("k", "cf", q)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if v == nil || string(v.Value) != want || v.Timestamp != row.Get("cf", q).Timestamp {
+					t.Errorf("%s after replay = %v, want %q at %d", q, v, want, row.Get("cf", q).Timestamp)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package tablet
+
+import (
+	"fmt"
+	"time"
+)
+
+// ScanOptions controls a range scan.
+type ScanOptions struct {
+	// Limit is the maximum number of rows returned. Zero means no limit.
+	// Rows the filter leaves empty do not count.
+	Limit int
+	// Filter, if set, is applied to every row; rows left without cells are skipped.
+	Filter *RowFilter
+}
+
+// Scan returns an iterator over the rows with keys in [start, end), in increasing order.
+// An empty end scans to the end of the tablet. The range is clamped to the tablet's own range.
+//
+// Rows are merged on the fly from the MemTable and every SSTable; SSTables are read
+// one block at a time, so a scan never loads whole files into memory.
+// The caller must Close the iterator, and may do so before it is exhausted.
+func (t *Tablet) Scan(start, end string, opts ScanOptions) (RowIterator, error) {
+	var filter filterFunc
+	if opts.Filter != nil {
+		var err error
+		if filter, err = compileRowFilter(opts.Filter); err != nil {
+			return nil, fmt.Errorf("invalid row filter: %w", err)
+		}
+	}
+
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	if t.closed {
+		return nil, ErrTabletClosed
+	}
+	if start < t.StartKey {
+		start = t.StartKey
+	}
+	if t.EndKey != "" && (end == "" || end > t.EndKey) {
+		end = t.EndKey
+	}
+
+	iters := make([]RowIterator, 0, len(t.SSTables)+len(t.Immutable)+1)
+	for _, m := range t.memTables() {
+		iters = append(iters, newMemTableIterator(m, start, end))
+	}
+	for i := len(t.SSTables) - 1; i >= 0; i-- {
+		iters = append(iters, newSSTableIterator(t.SSTables[i].reader, start, end))
+	}
+
+	var it RowIterator = &liveIterator{
+		RowIterator: newMergeIterator(iters),
+		schema:      t.Schema,
+		now:         time.Now().UnixNano(),
+		filter:      filter,
+	}
+	if opts.Limit > 0 {
+		it = &limitIterator{RowIterator: it, remaining: opts.Limit}
+	}
+	return it, nil
+}
+
+// liveIterator applies GC rules to merged rows, strips the tombstones,
+// applies the row filter, if any, and skips rows with no cells left, so callers
+// only ever see visible data.
+type liveIterator struct {
+	RowIterator
+	schema *Schema
+	now    int64
+	filter filterFunc
+}
+
+func (it *liveIterator) Next() bool {
+	for it.RowIterator.Next() {
+		row := it.RowIterator.Row()
+		row.applyGC(it.schema, it.now)
+		row.dropTombstones()
+		if it.filter != nil {
+			row.applyFilter(it.filter)
+		}
+		if len(row.Columns) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// limitIterator stops after a fixed number of rows.
+type limitIterator struct {
+	RowIterator
+	remaining int
+}
+
+func (it *limitIterator) Next() bool {
+	if it.remaining <= 0 {
+		return false
+	}
+	if !it.RowIterator.Next() {
+		return false
+	}
+	it.remaining--
+	return true
+}
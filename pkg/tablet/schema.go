@@ -0,0 +1,286 @@
+package tablet
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+// ErrUnknownColumnFamily is returned when a mutation references a column family
+// that has not been declared in the tablet's schema.
+var ErrUnknownColumnFamily = errors.New("unknown column family")
+
+// schemaFileName is the file inside the tablet directory holding the schema.
+const schemaFileName = "schema.json"
+
+// GCRule decides which versions of a column can be garbage collected.
+// Exactly one field should be set; the zero GCRule never collects anything.
+// This mirrors Cloud Bigtable's GcRule.
+type GCRule struct {
+	// MaxVersions keeps only the N most recent versions of each column.
+	MaxVersions int `json:",omitempty"`
+	// MaxAge collects versions whose timestamp is older than now minus MaxAge.
+	MaxAge time.Duration `json:",omitempty"`
+	// Union collects a version if any of the rules would collect it.
+	Union []GCRule `json:",omitempty"`
+	// Intersection collects a version only if every rule would collect it.
+	Intersection []GCRule `json:",omitempty"`
+}
+
+// MaxVersionsGCRule keeps at most n versions of each column.
+func MaxVersionsGCRule(n int) GCRule {
+	return GCRule{MaxVersions: n}
+}
+
+// MaxAgeGCRule collects versions older than age.
+func MaxAgeGCRule(age time.Duration) GCRule {
+	return GCRule{MaxAge: age}
+}
+
+// UnionGCRule collects versions matched by any of the rules.
+func UnionGCRule(rules ...GCRule) GCRule {
+	return GCRule{Union: rules}
+}
+
+// IntersectionGCRule collects versions matched by all of the rules.
+func IntersectionGCRule(rules ...GCRule) GCRule {
+	return GCRule{Intersection: rules}
+}
+
+// Validate checks that at most one kind of rule is set, recursively.
+func (r GCRule) Validate() error {
+	set := 0
+	if r.MaxVersions != 0 {
+		set++
+	}
+	if r.MaxAge != 0 {
+		set++
+	}
+	if len(r.Union) > 0 {
+		set++
+	}
+	if len(r.Intersection) > 0 {
+		set++
+	}
+	if set > 1 {
+		return fmt.Errorf("gc rule must set only one of MaxVersions, MaxAge, Union or Intersection")
+	}
+	if r.MaxVersions < 0 || r.MaxAge < 0 {
+		return fmt.Errorf("gc rule limits must not be negative")
+	}
+	for _, sub := range append(r.Union, r.Intersection...) {
+		if err := sub.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// collect reports, for each version (sorted newest first), whether the rule collects it.
+// now is in the same unit as cell timestamps (Unix nanoseconds).
+func (r GCRule) collect(versions []CellVersion, now int64) []bool {
+	out := make([]bool, len(versions))
+	switch {
+	case len(r.Union) > 0:
+		for _, sub := range r.Union {
+			for i, c := range sub.collect(versions, now) {
+				out[i] = out[i] || c
+			}
+		}
+	case len(r.Intersection) > 0:
+		for i := range out {
+			out[i] = true
+		}
+		for _, sub := range r.Intersection {
+			for i, c := range sub.collect(versions, now) {
+				out[i] = out[i] && c
+			}
+		}
+	case r.MaxVersions > 0:
+		for i := r.MaxVersions; i < len(versions); i++ {
+			out[i] = true
+		}
+	case r.MaxAge > 0:
+		cutoff := now - int64(r.MaxAge)
+		for i, v := range versions {
+			out[i] = v.Timestamp < cutoff
+		}
+	}
+	return out
+}
+
+// ColumnFamily is a declared column family and its garbage-collection policy.
+type ColumnFamily struct {
+	Name   string
+	GCRule GCRule
+}
+
+// Schema lists the column families a tablet accepts writes for.
+// A Schema is never modified once published to a Tablet; changes build a new one.
+type Schema struct {
+	Families map[string]ColumnFamily
+}
+
+// NewSchema creates a schema with the given families.
+func NewSchema(families ...ColumnFamily) *Schema {
+	s := &Schema{Families: make(map[string]ColumnFamily)}
+	for _, f := range families {
+		s.Families[f.Name] = f
+	}
+	return s
+}
+
+// Clone returns a copy of the schema that can be modified independently.
+func (s *Schema) Clone() *Schema {
+	c := NewSchema()
+	for name, f := range s.Families {
+		c.Families[name] = f
+	}
+	return c
+}
+
+// validateMutation rejects malformed operations and operations on undeclared
+// column families.
+func (s *Schema) validateMutation(m *RowMutation) error {
+	if err := m.Validate(); err != nil {
+		return err
+	}
+	for _, op := range m.Ops {
+		if op.Type == MutationDeleteRow {
+			continue
+		}
+		if _, ok := s.Families[op.Family]; !ok {
+			return fmt.Errorf("%w %q", ErrUnknownColumnFamily, op.Family)
+		}
+	}
+	return nil
+}
+
+// applyGC removes the versions of r collected by the GC rules of their families.
+// Sources should be merged first so that only live versions count towards the rules.
+func (r *Row) applyGC(s *Schema, now int64) {
+	for colKey, col := range r.Columns {
+		family, ok := s.Families[col.Family]
+		if !ok {
+			continue
+		}
+		collected := family.GCRule.collect(col.Versions, now)
+		kept := col.Versions[:0]
+		for i, v := range col.Versions {
+			if !collected[i] {
+				kept = append(kept, v)
+			}
+		}
+		col.Versions = kept
+		if len(col.Versions) == 0 && len(col.Tombstones) == 0 {
+			delete(r.Columns, colKey)
+		}
+	}
+}
+
+// loadSchema reads the schema stored in a tablet directory.
+// A missing file yields an empty schema.
+func loadSchema(dir string) (*Schema, error) {
+	data, err := os.ReadFile(filepath.Join(dir, schemaFileName))
+	if os.IsNotExist(err) {
+		return NewSchema(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	s := NewSchema()
+	if err := json.Unmarshal(data, s); err != nil {
+		return nil, fmt.Errorf("failed to parse schema: %w", err)
+	}
+	return s, nil
+}
+
+// save writes the schema into a tablet directory, replacing the previous one atomically.
+func (s *Schema) save(dir string) error {
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	path := filepath.Join(dir, schemaFileName)
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// SetColumnFamily declares a column family, or replaces the GC rule of an existing one.
+// The new schema is persisted before it takes effect.
+func (t *Tablet) SetColumnFamily(family ColumnFamily) error {
+	if family.Name == "" {
+		return fmt.Errorf("column family name must not be empty")
+	}
+	if err := family.GCRule.Validate(); err != nil {
+		return err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.closed {
+		return ErrTabletClosed
+	}
+	s := t.Schema.Clone()
+	s.Families[family.Name] = family
+	if err := s.save(t.Dir); err != nil {
+		return fmt.Errorf("failed to save schema: %w", err)
+	}
+	t.Schema = s
+	return nil
+}
+
+// DeleteColumnFamily removes a column family from the schema.
+// Existing data of the family is no longer writable; it is left on disk.
+func (t *Tablet) DeleteColumnFamily(name string) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.closed {
+		return ErrTabletClosed
+	}
+	if _, ok := t.Schema.Families[name]; !ok {
+		return fmt.Errorf("%w %q", ErrUnknownColumnFamily, name)
+	}
+	s := t.Schema.Clone()
+	delete(s.Families, name)
+	if err := s.save(t.Dir); err != nil {
+		return fmt.Errorf("failed to save schema: %w", err)
+	}
+	t.Schema = s
+	return nil
+}
+
+// ColumnFamilies returns the column families of the tablet, sorted by name.
+func (t *Tablet) ColumnFamilies() []ColumnFamily {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	families := make([]ColumnFamily, 0, len(t.Schema.Families))
+	for _, f := range t.Schema.Families {
+		families = append(families, f)
+	}
+	sort.Slice(families, func(i, j int) bool { return families[i].Name < families[j].Name })
+	return families
+}
+
+// adoptSchema replaces the tablet's schema with a copy of s, e.g. when creating split children.
+func (t *Tablet) adoptSchema(s *Schema) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	c := s.Clone()
+	if err := c.save(t.Dir); err != nil {
+		return err
+	}
+	t.Schema = c
+	return nil
+}
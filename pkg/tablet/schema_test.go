@@ -0,0 +1,117 @@
+package tablet
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+// versionTimestamps returns the timestamps of versions, in order.
+func versionTimestamps(versions []CellVersion) []int64 {
+	var ts []int64
+	for _, v := range versions {
+		ts = append(ts, v.Timestamp)
+	}
+	return ts
+}
+
+func TestGCRules(t *testing.T) {
+	now := time.Now().UnixNano()
+	// Versions of cf:q, newest first: two recent ones and two over an hour old.
+	recent1, recent2 := now-int64(time.Second), now-2*int64(time.Second)
+	old1, old2 := now-int64(time.Hour), now-2*int64(time.Hour)
+
+	tests := []struct {
+		name string
+		rule GCRule
+		want []int64
+	}{
+		{"none", GCRule{}, []int64{recent1, recent2, old1, old2}},
+		{"max versions", MaxVersionsGCRule(3), []int64{recent1, recent2, old1}},
+		{"max age", MaxAgeGCRule(time.Minute), []int64{recent1, recent2}},
+		{"union", UnionGCRule(MaxVersionsGCRule(1), MaxAgeGCRule(time.Minute)), []int64{recent1}},
+		{"intersection", IntersectionGCRule(MaxVersionsGCRule(3), MaxAgeGCRule(time.Minute)), []int64{recent1, recent2, old1}},
+		{"intersection keeps recent", IntersectionGCRule(MaxVersionsGCRule(1), MaxAgeGCRule(time.Minute)), []int64{recent1, recent2}},
+		{"nested", UnionGCRule(MaxVersionsGCRule(3), IntersectionGCRule(MaxVersionsGCRule(1), MaxAgeGCRule(time.Minute))), []int64{recent1, recent2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tb := openTestTablet(t, t.TempDir(), testOptions())
+			if err := tb.SetColumnFamily(ColumnFamily{Name: "cf", GCRule: tt.rule}); err != nil {
+				t.Fatal(err)
+			}
+			// Spread the versions over two SSTables and the MemTable.
+			mustMutate(t, tb, set("k", "q", old2, "old2"))
+			mustMutate(t, tb, set("k", "q", old1, "old1"))
+			flushTestTablet(t, tb)
+			mustMutate(t, tb, set("k", "q", recent2, "recent2"))
+			flushTestTablet(t, tb)
+			mustMutate(t, tb, set("k", "q", recent1, "recent1"))
+
+			// Reads hide collected versions before compaction drops them.
+			versions, err := tb.ReadVersions("k", "cf", "q", ReadOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := versionTimestamps(versions); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ReadVersions = %v, want %v", got, tt.want)
+			}
+			row, err := tb.ReadRow("k", ReadFilter{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := versionTimestamps(row.Columns["cf:q"].Versions); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ReadRow = %v, want %v", got, tt.want)
+			}
+			it, err := tb.Scan("", "", ScanOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !it.Next() {
+				t.Fatalf("Scan found no row: %v", it.Err())
+			}
+			if got := versionTimestamps(it.Row().Columns["cf:q"].Versions); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Scan = %v, want %v", got, tt.want)
+			}
+			it.Close()
+
+			// Compaction drops them from disk.
+			flushTestTablet(t, tb)
+			compactTestTablet(t, tb, 0, len(tb.SSTables))
+			if len(tb.SSTables) != 1 {
+				t.Fatalf("%d SSTables after a full compaction, want 1", len(tb.SSTables))
+			}
+			stored, err := tb.SSTables[0].reader.Get("k")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := versionTimestamps(stored.Columns["cf:q"].Versions); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("compacted SSTable holds %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// A collected version does not uncover an older one, even though compaction only
+// saw some of the versions.
+func TestGCRuleAfterMinorCompaction(t *testing.T) {
+	tb := openTestTablet(t, t.TempDir(), testOptions())
+	if err := tb.SetColumnFamily(ColumnFamily{Name: "cf", GCRule: MaxVersionsGCRule(1)}); err != nil {
+		t.Fatal(err)
+	}
+	mustMutate(t, tb, set("k", "q", 1, "v1"))
+	flushTestTablet(t, tb)
+	mustMutate(t, tb, set("k", "q", 2, "v2"))
+	flushTestTablet(t, tb)
+	mustMutate(t, tb, set("k", "q", 3, "v3"))
+	flushTestTablet(t, tb)
+
+	compactTestTablet(t, tb, 1, 3)
+	versions, err := tb.ReadVersions("k", "cf", "q", ReadOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := versionTimestamps(versions); !reflect.DeepEqual(got, []int64{3}) {
+		t.Errorf("versions after a minor compaction = %v, want [3]", got)
+	}
+}
@@ -13,34 +13,30 @@ func (t *Tablet) Split(thresholdBytes int64) (*Tablet, *Tablet, error) {
 	t.mu.Lock()
 	defer t.mu.Unlock()
 
-	// 1. Check size:
-	// We check MemTable size only for now, as that's what we track easily in this prototype.
-	// In a real system, we'd sum SSTable sizes too.
-	currentSize := t.MemTable.SizeBytes
-	// Also assume 0 for SSTable sizes unless we parse file stats (out of scope for quick impl).
-	
+	if t.closed {
+		return nil, nil, ErrTabletClosed
+	}
+
+	// 1. Check size
+	currentSize := t.sizeLocked()
+
 	if currentSize < thresholdBytes {
 		return nil, nil, fmt.Errorf("tablet size %d is below threshold %d", currentSize, thresholdBytes)
 	}
 
 	// 2. Prepare to Snapshot/Compact for Split
-	// For simplicity, we flush the memtable first so we have everything in SSTables (or we could keep in MemTable).
-	// Let's Flush to unify data.
-	sstPath := filepath.Join(t.Dir, fmt.Sprintf("split_temp.sst"))
-	// Careful: Tablet struct tracks SSTables, but MemTable.Flush just writes to disk and clears Memtree.
-	// We'll manually call Flush on MemTable.
-	meta, err := t.MemTable.Flush(sstPath)
-	if err != nil {
+	// For simplicity, we flush the memtables first so we have everything in SSTables.
+	// Frozen MemTables not yet written by the background flusher are flushed too.
+	if err := t.flushAllLocked(); err != nil {
 		return nil, nil, fmt.Errorf("failed to flush for split: %v", err)
 	}
-	t.SSTables = append(t.SSTables, *meta)
 
 	// 3. Find Median Key
 	// Read all rows from all SSTables to find split point.
 	// This is expensive but correct for "Basic" implementation.
 	var allRows []*Row
 	for _, sst := range t.SSTables {
-		rows, err := ReadSSTable(sst.Path)
+		rows, err := sst.reader.Rows()
 		if err != nil {
 			return nil, nil, fmt.Errorf("failed to read sst for split: %v", err)
 		}
@@ -51,8 +47,9 @@ func (t *Tablet) Split(thresholdBytes int64) (*Tablet, *Tablet, error) {
 		return nil, nil, fmt.Errorf("not enough rows to split")
 	}
 
-	// Sort by key
-	sort.Slice(allRows, func(i, j int) bool {
+	// Sort by key. The sort is stable, so the copies of a row stay oldest first,
+	// the order in which they are applied to the children below.
+	sort.SliceStable(allRows, func(i, j int) bool {
 		return allRows[i].Key < allRows[j].Key
 	})
 
@@ -71,16 +68,24 @@ func (t *Tablet) Split(thresholdBytes int64) (*Tablet, *Tablet, error) {
 	// Create directories if not exist (NewTablet does this)
 	// Note: Directory naming is safe only if keys are filesystem-safe. Assuming simple alphanumeric keys for now.
 
-	leftTablet, err := NewTablet(t.StartKey, splitKey, dirLeft)
+	leftTablet, err := NewTabletWithOptions(t.StartKey, splitKey, dirLeft, t.Options)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to create left tablet: %v", err)
 	}
 
-	rightTablet, err := NewTablet(splitKey, t.EndKey, dirRight)
+	rightTablet, err := NewTabletWithOptions(splitKey, t.EndKey, dirRight, t.Options)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to create right tablet: %v", err)
 	}
 
+	// Children serve the same column families as the parent.
+	if err := leftTablet.adoptSchema(t.Schema); err != nil {
+		return nil, nil, fmt.Errorf("failed to copy schema to left tablet: %v", err)
+	}
+	if err := rightTablet.adoptSchema(t.Schema); err != nil {
+		return nil, nil, fmt.Errorf("failed to copy schema to right tablet: %v", err)
+	}
+
 	// 5. Partition Data
 	// For each row, decide where it goes.
 	// Since we already have 'allRows' in memory, just apply them to the new tablets' MemTables.
@@ -106,8 +111,20 @@ func (t *Tablet) Split(thresholdBytes int64) (*Tablet, *Tablet, error) {
 }
 
 // rowToMutation is a helper to convert a Row back to a Set mutation for migration.
+// Tombstones are carried over as delete operations ahead of the sets.
 func rowToMutation(r *Row) *RowMutation {
 	m := NewRowMutation(r.Key)
+	if r.DeletedAt != 0 {
+		m.Ops = append(m.Ops, MutationOperation{Type: MutationDeleteRow, Timestamp: r.DeletedAt})
+	}
+	for family, ts := range r.FamilyDeletedAt {
+		m.Ops = append(m.Ops, MutationOperation{Type: MutationDeleteFamily, Family: family, Timestamp: ts})
+	}
+	for _, col := range r.Columns {
+		for _, tr := range col.Tombstones {
+			m.AddDeleteTimeRange(col.Family, col.Qualifier, tr.Start, tr.End)
+		}
+	}
 	for _, col := range r.Columns {
 		for _, ver := range col.Versions {
 			m.AddSet(col.Family, col.Qualifier, ver.Timestamp, ver.Value)
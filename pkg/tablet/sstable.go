@@ -1,37 +1,120 @@
 package tablet
 
 import (
-	"encoding/json"
+	"bufio"
+	"encoding/binary"
+	"fmt"
 	"io"
 	"os"
+	"sort"
+	"sync/atomic"
 
 	"github.com/google/btree"
 )
 
+// SSTable file layout:
+//
+//	[data block 0] ... [data block N-1] [filter block] [index block] [footer]
+//
+// Data blocks hold sorted row entries (see blockBuilder). The filter block holds the
+// Bloom filters (see bloomBuilder). The index block holds one blockHandle per data block.
+// The footer is fixed size for a given format version, and always ends with the
+// version and the magic number so a reader can locate it from the end of the file.
+//
+//	footer v1: indexOffset(u64) indexLength(u64) version(u32) magic(u32)
+//	footer v2: indexOffset(u64) indexLength(u64) filterOffset(u64) filterLength(u64) version(u32) magic(u32)
+//
+// Version 1 files have no filter block and are still readable.
+const (
+	sstableMagic   uint32 = 0x42544254 // "BTBT"
+	sstableVersion uint32 = 2
+
+	sstableFooterSizeV1 = 24
+	sstableFooterSizeV2 = 40
+
+	// DefaultBlockSize is the target size of a data block when none is configured.
+	DefaultBlockSize = 4 * 1024
+)
+
+// SSTableOptions controls how SSTable files are laid out on disk.
+type SSTableOptions struct {
+	// BlockSize is the target size in bytes of each data block.
+	// A block is closed as soon as it reaches this size, so blocks may be slightly larger.
+	BlockSize int
+
+	// Bloom configures the Bloom filters written alongside the data blocks.
+	Bloom BloomFilterOptions
+}
+
 // SSTableMetadata represents an SSTable on disk
 type SSTableMetadata struct {
 	Path string
+	Size int64 // File size in bytes
+
+	FirstKey string // Smallest row key in the file
+	LastKey  string // Largest row key in the file
+	// MaxSeq is the highest commit log sequence number whose data the file holds,
+	// or 0 if unknown (files adopted from before the manifest existed).
+	MaxSeq uint64
+
+	reader *SSTableReader
+}
+
+// openSSTableMetadata opens the SSTable at path and keeps the reader for later lookups.
+func openSSTableMetadata(path string) (*SSTableMetadata, error) {
+	r, err := OpenSSTable(path)
+	if err != nil {
+		return nil, err
+	}
+	first, last, err := r.keyRange()
+	if err != nil {
+		r.Close()
+		return nil, err
+	}
+	return &SSTableMetadata{Path: path, Size: r.size, FirstKey: first, LastKey: last, reader: r}, nil
 }
 
 // FlushMemTable writes the current MemTable to an SSTable file and clears the MemTable.
-func (m *MemTable) Flush(path string) (*SSTableMetadata, error) {
+func (m *MemTable) Flush(path string, opts SSTableOptions) (*SSTableMetadata, error) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	f, err := os.Create(path)
+	meta, err := m.writeSSTableLocked(path, opts)
 	if err != nil {
 		return nil, err
 	}
-	defer f.Close()
 
-	enc := json.NewEncoder(f)
+	// Clear MemTable
+	m.Tree.Clear(false)
+	m.SizeBytes = 0
+
+	return meta, nil
+}
+
+// WriteSSTable writes the MemTable to an SSTable file without clearing it.
+// Readers are not blocked while the file is written, which is how frozen
+// MemTables stay readable until their SSTable replaces them.
+func (m *MemTable) WriteSSTable(path string, opts SSTableOptions) (*SSTableMetadata, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.writeSSTableLocked(path, opts)
+}
+
+// writeSSTableLocked assumes the lock is held (for reading at least).
+func (m *MemTable) writeSSTableLocked(path string, opts SSTableOptions) (*SSTableMetadata, error) {
+	w, err := NewSSTableWriter(path, opts)
+	if err != nil {
+		return nil, err
+	}
 
 	// Iterate over the BTree and write rows
 	// btree.Ascend is in-order traversal (sorted by key)
 	var writeErr error
 	m.Tree.Ascend(func(i btree.Item) bool {
 		row := i.(RowItem).Row
-		if err := enc.Encode(row); err != nil {
+		row.mu.RLock()
+		defer row.mu.RUnlock()
+		if err := w.Add(row); err != nil {
 			writeErr = err
 			return false // stop iteration
 		}
@@ -39,39 +122,399 @@ func (m *MemTable) Flush(path string) (*SSTableMetadata, error) {
 	})
 
 	if writeErr != nil {
+		w.Abort()
 		return nil, writeErr
 	}
+	if err := w.Finish(); err != nil {
+		return nil, err
+	}
 
-	// Clear MemTable
-	m.Tree.Clear(false)
-	m.SizeBytes = 0
+	return openSSTableMetadata(path)
+}
+
+// SSTableWriter builds an SSTable file from rows added in increasing key order.
+// The file is written under a temporary name and only renamed to its final path
+// by Finish, so a crash never leaves a partial SSTable behind under a .sst name.
+type SSTableWriter struct {
+	path string
+	f    *os.File
+	w    *bufio.Writer
+	opts SSTableOptions
 
-	return &SSTableMetadata{Path: path}, nil
+	offset  uint64
+	block   blockBuilder
+	index   []blockHandle
+	bloom   *bloomBuilder
+	lastKey string
+	rows    int
 }
 
-// ReadSSTable reads all rows from an SSTable file.
-// In a real system, we would have an index or scan iterator.
-// For this prototype, we load all into memory for simplicity in Compaction.
-func ReadSSTable(path string) ([]*Row, error) {
+// NewSSTableWriter creates the file at path and prepares it for writing.
+func NewSSTableWriter(path string, opts SSTableOptions) (*SSTableWriter, error) {
+	if opts.BlockSize <= 0 {
+		opts.BlockSize = DefaultBlockSize
+	}
+	f, err := os.Create(path + ".tmp")
+	if err != nil {
+		return nil, err
+	}
+	return &SSTableWriter{
+		path:  path,
+		f:     f,
+		w:     bufio.NewWriter(f),
+		opts:  opts,
+		bloom: newBloomBuilder(opts.Bloom),
+	}, nil
+}
+
+// Add appends a row to the SSTable.
+// Rows must be added in strictly increasing key order.
+func (w *SSTableWriter) Add(row *Row) error {
+	if w.rows > 0 && row.Key <= w.lastKey {
+		return fmt.Errorf("sstable rows out of order: %q after %q", row.Key, w.lastKey)
+	}
+
+	w.block.add(row.Key, encodeRow(row))
+	w.bloom.add(row)
+	w.lastKey = row.Key
+	w.rows++
+
+	if w.block.size() >= w.opts.BlockSize {
+		return w.flushBlock()
+	}
+	return nil
+}
+
+// flushBlock writes the pending data block and records it in the index.
+func (w *SSTableWriter) flushBlock() error {
+	if w.block.empty() {
+		return nil
+	}
+	n, err := w.w.Write(w.block.buf)
+	if err != nil {
+		return err
+	}
+	w.index = append(w.index, blockHandle{
+		LastKey: w.block.lastKey,
+		Offset:  w.offset,
+		Length:  uint64(n),
+	})
+	w.offset += uint64(n)
+	w.block.reset()
+	return nil
+}
+
+// Finish writes the remaining block, the filters, the index and the footer,
+// then syncs and closes the file.
+func (w *SSTableWriter) Finish() error {
+	if err := w.flushBlock(); err != nil {
+		w.Abort()
+		return err
+	}
+
+	filterOffset := w.offset
+	filter := w.bloom.encode()
+	if _, err := w.w.Write(filter); err != nil {
+		w.Abort()
+		return err
+	}
+	w.offset += uint64(len(filter))
+
+	indexOffset := w.offset
+	index := encodeIndex(w.index)
+	if _, err := w.w.Write(index); err != nil {
+		w.Abort()
+		return err
+	}
+
+	footer := make([]byte, sstableFooterSizeV2)
+	binary.LittleEndian.PutUint64(footer[0:], indexOffset)
+	binary.LittleEndian.PutUint64(footer[8:], uint64(len(index)))
+	binary.LittleEndian.PutUint64(footer[16:], filterOffset)
+	binary.LittleEndian.PutUint64(footer[24:], uint64(len(filter)))
+	binary.LittleEndian.PutUint32(footer[32:], sstableVersion)
+	binary.LittleEndian.PutUint32(footer[36:], sstableMagic)
+	if _, err := w.w.Write(footer); err != nil {
+		w.Abort()
+		return err
+	}
+
+	if err := w.w.Flush(); err != nil {
+		w.Abort()
+		return err
+	}
+	if err := w.f.Sync(); err != nil {
+		w.Abort()
+		return err
+	}
+	if err := w.f.Close(); err != nil {
+		os.Remove(w.f.Name())
+		return err
+	}
+	return os.Rename(w.f.Name(), w.path)
+}
+
+// Abort closes and removes a partially written SSTable.
+func (w *SSTableWriter) Abort() {
+	w.f.Close()
+	os.Remove(w.f.Name())
+}
+
+// SSTableReader provides random access to an SSTable file.
+// Only the footer, block index and Bloom filters are held in memory;
+// data blocks are read on demand. It is safe for concurrent use.
+//
+// Readers owned by a Tablet are reference counted: iterators take a reference
+// so that a compaction can retire the file without breaking in-flight scans.
+// The file is closed (and, if obsolete, deleted) when the last reference is dropped.
+type SSTableReader struct {
+	path    string
+	f       *os.File
+	size    int64
+	index   []blockHandle
+	filters map[string]*BloomFilter
+
+	refs     atomic.Int32
+	obsolete atomic.Bool
+}
+
+// OpenSSTable opens an SSTable file and loads its block index and filters.
+func OpenSSTable(path string) (*SSTableReader, error) {
 	f, err := os.Open(path)
 	if err != nil {
 		return nil, err
 	}
-	defer f.Close()
 
+	r := &SSTableReader{path: path, f: f}
+	if err := r.readFooter(); err != nil {
+		f.Close()
+		return nil, fmt.Errorf("sstable %s: %w", path, err)
+	}
+	r.refs.Store(1)
+	return r, nil
+}
+
+// readFooter validates the footer and decodes the block index and filter block.
+func (r *SSTableReader) readFooter() error {
+	info, err := r.f.Stat()
+	if err != nil {
+		return err
+	}
+	size := info.Size()
+	r.size = size
+	if size < 8 {
+		return fmt.Errorf("file too small (%d bytes)", size)
+	}
+
+	tail := make([]byte, 8)
+	if _, err := r.f.ReadAt(tail, size-8); err != nil {
+		return err
+	}
+	version := binary.LittleEndian.Uint32(tail[0:])
+	if magic := binary.LittleEndian.Uint32(tail[4:]); magic != sstableMagic {
+		return fmt.Errorf("bad magic number %#x", magic)
+	}
+
+	var footerSize int64
+	switch version {
+	case 1:
+		footerSize = sstableFooterSizeV1
+	case 2:
+		footerSize = sstableFooterSizeV2
+	default:
+		return fmt.Errorf("unsupported format version %d", version)
+	}
+	if size < footerSize {
+		return fmt.Errorf("file too small (%d bytes)", size)
+	}
+
+	footer := make([]byte, footerSize)
+	if _, err := r.f.ReadAt(footer, size-footerSize); err != nil {
+		return err
+	}
+	dataEnd := uint64(size - footerSize)
+
+	indexOffset := binary.LittleEndian.Uint64(footer[0:])
+	indexLength := binary.LittleEndian.Uint64(footer[8:])
+	buf, err := r.readSection(indexOffset, indexLength, dataEnd)
+	if err != nil {
+		return fmt.Errorf("index: %w", err)
+	}
+	if r.index, err = decodeIndex(buf); err != nil {
+		return fmt.Errorf("index: %w", err)
+	}
+
+	if version >= 2 {
+		filterOffset := binary.LittleEndian.Uint64(footer[16:])
+		filterLength := binary.LittleEndian.Uint64(footer[24:])
+		buf, err := r.readSection(filterOffset, filterLength, dataEnd)
+		if err != nil {
+			return fmt.Errorf("filter: %w", err)
+		}
+		if r.filters, err = decodeFilterBlock(buf); err != nil {
+			return fmt.Errorf("filter: %w", err)
+		}
+	}
+	return nil
+}
+
+// readSection reads length bytes at offset, checking they lie before limit.
+func (r *SSTableReader) readSection(offset, length, limit uint64) ([]byte, error) {
+	if offset+length > limit {
+		return nil, fmt.Errorf("section out of bounds")
+	}
+	buf := make([]byte, length)
+	if _, err := r.f.ReadAt(buf, int64(offset)); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// Path returns the file path of the SSTable.
+func (r *SSTableReader) Path() string {
+	return r.path
+}
+
+// MayContain reports whether the SSTable may hold rowKey.
+// It returns true when the file has no row key filter.
+func (r *SSTableReader) MayContain(rowKey string) bool {
+	f, ok := r.filters[""]
+	return !ok || f.MayContain(rowKey)
+}
+
+// MayContainColumn reports whether the SSTable may hold the given column of rowKey.
+// It checks the row key filter and, if the family has one, the row+column filter.
+// A row or family tombstone covering the column also counts as a possible match.
+func (r *SSTableReader) MayContainColumn(rowKey, family, qualifier string) bool {
+	if !r.MayContain(rowKey) {
+		return false
+	}
+	f, ok := r.filters[family]
+	return !ok || f.MayContain(columnFilterKey(rowKey, qualifier)) || f.MayContain(tombstoneFilterKey(rowKey))
+}
+
+// readBlock reads the raw bytes of a data block.
+func (r *SSTableReader) readBlock(h blockHandle) ([]byte, error) {
+	buf := make([]byte, h.Length)
+	if _, err := r.f.ReadAt(buf, int64(h.Offset)); err != nil && err != io.EOF {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// keyRange returns the smallest and largest row key of the SSTable.
+// The largest comes from the index; the smallest costs one block read.
+func (r *SSTableReader) keyRange() (first, last string, err error) {
+	if len(r.index) == 0 {
+		return "", "", nil
+	}
+	data, err := r.readBlock(r.index[0])
+	if err != nil {
+		return "", "", err
+	}
+	err = iterateBlock(data, func(k string, _ []byte) bool {
+		first = k
+		return false
+	})
+	return first, r.index[len(r.index)-1].LastKey, err
+}
+
+// Get returns the row stored under key, or nil if the SSTable does not contain it.
+// At most one data block is read.
+func (r *SSTableReader) Get(key string) (*Row, error) {
+	// First block whose last key is >= key is the only one that can hold it.
+	i := sort.Search(len(r.index), func(i int) bool {
+		return r.index[i].LastKey >= key
+	})
+	if i == len(r.index) {
+		return nil, nil
+	}
+
+	data, err := r.readBlock(r.index[i])
+	if err != nil {
+		return nil, err
+	}
+
+	var value []byte
+	found := false
+	err = iterateBlock(data, func(k string, v []byte) bool {
+		if k < key {
+			return true
+		}
+		if k == key {
+			value, found = v, true
+		}
+		return false
+	})
+	if err != nil || !found {
+		return nil, err
+	}
+	return decodeRow(key, value)
+}
+
+// Rows reads every row in the SSTable in key order.
+func (r *SSTableReader) Rows() ([]*Row, error) {
 	var rows []*Row
-	dec := json.NewDecoder(f)
-	for {
-		var r Row
-		if err := dec.Decode(&r); err == io.EOF {
-			break
-		} else if err != nil {
+	for _, h := range r.index {
+		data, err := r.readBlock(h)
+		if err != nil {
+			return nil, err
+		}
+		var decodeErr error
+		err = iterateBlock(data, func(k string, v []byte) bool {
+			row, err := decodeRow(k, v)
+			if err != nil {
+				decodeErr = err
+				return false
+			}
+			rows = append(rows, row)
+			return true
+		})
+		if err != nil {
 			return nil, err
 		}
-		// dec.Decode allocates new underlying arrays for slices if we pointer correctly,
-		// but since 'Versions' is a slice, we must be careful.
-		// Use a fresh struct each time to avoid sharing underlying arrays if decoder reuses (it typically doesn't for structs).
-		rows = append(rows, &r)
+		if decodeErr != nil {
+			return nil, decodeErr
+		}
 	}
 	return rows, nil
 }
+
+// Close releases the underlying file immediately, regardless of references.
+func (r *SSTableReader) Close() error {
+	return r.f.Close()
+}
+
+// ref takes a reference on the reader.
+func (r *SSTableReader) ref() {
+	r.refs.Add(1)
+}
+
+// unref drops a reference. The last one closes the file, and removes it if it is obsolete.
+func (r *SSTableReader) unref() {
+	if r.refs.Add(-1) > 0 {
+		return
+	}
+	r.f.Close()
+	if r.obsolete.Load() {
+		os.Remove(r.path)
+	}
+}
+
+// retire marks the file obsolete and drops the owner's reference.
+// The file is deleted once no iterator uses it any more.
+func (r *SSTableReader) retire() {
+	r.obsolete.Store(true)
+	r.unref()
+}
+
+// ReadSSTable reads all rows from an SSTable file.
+// Point lookups should use SSTableReader.Get instead, which only reads one block.
+func ReadSSTable(path string) ([]*Row, error) {
+	r, err := OpenSSTable(path)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return r.Rows()
+}
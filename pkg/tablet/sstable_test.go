@@ -0,0 +1,159 @@
+package tablet
+
+import (
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// writeTestSSTable writes rows, which must be sorted by key, to a new SSTable.
+func writeTestSSTable(t *testing.T, rows []*Row, opts SSTableOptions) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "000001.sst")
+	w, err := NewSSTableWriter(path, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, row := range rows {
+		if err := w.Add(row); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Finish(); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func testRows(n int) []*Row {
+	rows := make([]*Row, 0, n)
+	for i := 0; i < n; i++ {
+		row := NewRow(fmt.Sprintf("row%04d", i))
+		row.Set("cf", "a", 2, []byte(fmt.Sprintf("a%d-new", i)))
+		row.Set("cf", "a", 1, []byte(fmt.Sprintf("a%d-old", i)))
+		row.Set("cf", "b", 1, []byte(fmt.Sprintf("b%d", i)))
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+func TestSSTableRoundTrip(t *testing.T) {
+	rows := testRows(200)
+	// Small blocks so that the index has many entries.
+	path := writeTestSSTable(t, rows, SSTableOptions{BlockSize: 256})
+
+	r, err := OpenSSTable(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	if len(r.index) < 10 {
+		t.Fatalf("got %d blocks, want many", len(r.index))
+	}
+
+	for _, want := range rows {
+		got, err := r.Get(want.Key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got == nil {
+			t.Fatalf("Get(%q) = nil", want.Key)
+		}
+		if !reflect.DeepEqual(got.Columns, want.Columns) {
+			t.Fatalf("Get(%q) columns = %+v, want %+v", want.Key, got.Columns, want.Columns)
+		}
+	}
+
+	for _, key := range []string{"", "row", "row0000\x00", "row0199\x00", "zzz"} {
+		got, err := r.Get(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != nil {
+			t.Errorf("Get(%q) = %v, want nil", key, got.Key)
+		}
+	}
+
+	all, err := r.Rows()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != len(rows) {
+		t.Fatalf("Rows returned %d rows, want %d", len(all), len(rows))
+	}
+	for i, row := range all {
+		if row.Key != rows[i].Key {
+			t.Fatalf("Rows()[%d] = %q, want %q", i, row.Key, rows[i].Key)
+		}
+	}
+}
+
+func TestSSTableTombstonesRoundTrip(t *testing.T) {
+	row := NewRow("k")
+	row.Set("cf", "a", 5, []byte("v"))
+	row.DeletedAt = 3
+	row.FamilyDeletedAt["other"] = 4
+	row.Columns["cf:b"] = &Column{Family: "cf", Qualifier: "b", Versions: []CellVersion{},
+		Tombstones: []TimestampRange{{Start: 1, End: 10}}}
+	path := writeTestSSTable(t, []*Row{row}, SSTableOptions{})
+
+	rows, err := ReadSSTable(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 1 {
+		t.Fatalf("got %d rows, want 1", len(rows))
+	}
+	got := rows[0]
+	if got.DeletedAt != 3 || got.FamilyDeletedAt["other"] != 4 {
+		t.Errorf("row tombstones = %d %v, want 3 map[other:4]", got.DeletedAt, got.FamilyDeletedAt)
+	}
+	if col := got.Columns["cf:b"]; col == nil || !reflect.DeepEqual(col.Tombstones, []TimestampRange{{Start: 1, End: 10}}) {
+		t.Errorf("column tombstones = %+v", col)
+	}
+	if v := got.Get("cf", "a"); v == nil || string(v.Value) != "v" {
+		t.Errorf("cf:a = %v, want v", v)
+	}
+}
+
+func TestSSTableWriterRejectsUnsortedRows(t *testing.T) {
+	w, err := NewSSTableWriter(filepath.Join(t.TempDir(), "000001.sst"), SSTableOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Abort()
+	if err := w.Add(NewRow("b")); err != nil {
+		t.Fatal(err)
+	}
+	for _, key := range []string{"a", "b"} {
+		if err := w.Add(NewRow(key)); err == nil {
+			t.Errorf("Add(%q) after \"b\" succeeded", key)
+		}
+	}
+}
+
+func TestSSTableBloomFilter(t *testing.T) {
+	rows := testRows(100)
+	path := writeTestSSTable(t, rows, SSTableOptions{Bloom: BloomFilterOptions{FalsePositiveRate: 0.01}})
+	r, err := OpenSSTable(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+
+	for _, row := range rows {
+		if !r.MayContain(row.Key) || !r.MayContainColumn(row.Key, "cf", "a") {
+			t.Fatalf("Bloom filter rules out %q, which the file holds", row.Key)
+		}
+	}
+	falsePositives := 0
+	for i := 0; i < 1000; i++ {
+		if r.MayContain(fmt.Sprintf("missing%d", i)) {
+			falsePositives++
+		}
+	}
+	if falsePositives > 50 {
+		t.Errorf("%d false positives out of 1000 at a 1%% target rate", falsePositives)
+	}
+}
@@ -1,10 +1,15 @@
 package tablet
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
 	"sync"
+	"sync/atomic"
+	"time"
 )
 
 // Tablet represents a contiguous range of rows in the table.
@@ -12,140 +17,372 @@ import (
 type Tablet struct {
 	mu sync.RWMutex
 
+	ID       string // Stable identifier, recorded in the manifest
 	StartKey string
 	EndKey   string // Exclusive. If empty, it means positive infinity (end of table).
 
 	Dir string // Directory for data storage (WAL, SSTables)
 
 	MemTable  *MemTable
+	Immutable []*MemTable // Frozen MemTables waiting to be flushed, oldest first
+	// SSTables are ordered oldest first: every file only holds data written
+	// after the data of the files before it. Flushes append, and compactions
+	// replace a contiguous run of files with their output in place.
 	SSTables  []SSTableMetadata
 	CommitLog *CommitLog
+
+	Options Options
+
+	// Schema declares the column families of the tablet. It is replaced, never
+	// modified in place, so a reader may keep using the pointer it loaded.
+	Schema *Schema
+
+	bloomHits, bloomMisses, bloomFalsePositives atomic.Int64
+
+	// nextFileNum numbers new SSTable files (see nextSSTablePath).
+	nextFileNum atomic.Uint64
+	compactions *compactionManager
+
+	flushWake chan struct{}
+	flushStop chan struct{}
+	flushWG   sync.WaitGroup
+
+	// closed is set by Close; operations that find it set fail with ErrTabletClosed.
+	closed    bool
+	closeOnce sync.Once
+	closeErr  error
 }
 
-// NewTablet initializes a new Tablet.
+// ErrTabletClosed is returned by operations on a tablet that was closed, for
+// example because it was unloaded while the operation waited for the lock.
+var ErrTabletClosed = errors.New("tablet is closed")
+
+// ErrKeyOutOfRange is returned by operations on a row the tablet does not cover.
+var ErrKeyOutOfRange = errors.New("key out of range")
+
+// NewTablet initializes a new Tablet with DefaultOptions.
 func NewTablet(start, end, dir string) (*Tablet, error) {
+	return NewTabletWithOptions(start, end, dir, DefaultOptions())
+}
+
+// NewTabletWithOptions initializes a new Tablet, or reopens the one stored in dir.
+// An existing tablet must cover exactly [start, end); see OpenTablet to reopen
+// a tablet without knowing its range.
+func NewTabletWithOptions(start, end, dir string, opts Options) (*Tablet, error) {
 	if err := os.MkdirAll(dir, 0755); err != nil {
 		return nil, err
 	}
 
+	manifest, err := loadManifest(dir)
+	if err != nil {
+		return nil, err
+	}
+	if manifest != nil && (manifest.StartKey != start || manifest.EndKey != end) {
+		return nil, fmt.Errorf("tablet in %s covers [%s, %s), not [%s, %s)", dir, manifest.StartKey, manifest.EndKey, start, end)
+	}
+
+	schema, err := loadSchema(dir)
+	if err != nil {
+		return nil, err
+	}
+
 	// Initialize Commit Log
-	walPath := filepath.Join(dir, "tablet.wal")
-	cl, err := NewCommitLog(walPath)
+	cl, err := NewCommitLog(dir, opts.CommitLog)
 	if err != nil {
 		return nil, err
 	}
 
-	// Recovery: Load existing SSTables
+	// Recovery: Load the live SSTables.
+	// With a manifest, only the files it lists are live; anything else is the
+	// output of a flush or compaction that crashed before it was published.
+	// Without one (a directory from an older version), every SSTable is adopted.
 	var sstables []SSTableMetadata
+	var maxFileNum uint64
+	live := make(map[string]bool)
+	if manifest != nil {
+		for _, ms := range manifest.SSTables {
+			meta, err := openSSTableMetadata(filepath.Join(dir, ms.File))
+			if err != nil {
+				return nil, fmt.Errorf("failed to open sstable: %w", err)
+			}
+			meta.MaxSeq = ms.MaxSeq
+			sstables = append(sstables, *meta)
+			live[ms.File] = true
+		}
+	}
+
 	files, err := os.ReadDir(dir)
 	if err != nil {
 		return nil, err
 	}
 
 	for _, f := range files {
+		// Leftover of an SSTable write interrupted by a crash.
+		if strings.HasSuffix(f.Name(), ".sst.tmp") {
+			os.Remove(filepath.Join(dir, f.Name()))
+			continue
+		}
 		if filepath.Ext(f.Name()) == ".sst" {
-			sstables = append(sstables, SSTableMetadata{Path: filepath.Join(dir, f.Name())})
+			if n, err := strconv.ParseUint(strings.TrimSuffix(f.Name(), ".sst"), 10, 64); err == nil && n > maxFileNum {
+				maxFileNum = n
+			}
+			if manifest != nil {
+				if !live[f.Name()] {
+					fmt.Printf("Removing %s from %s: not in manifest\n", f.Name(), dir)
+					os.Remove(filepath.Join(dir, f.Name()))
+				}
+				continue
+			}
+			meta, err := openSSTableMetadata(filepath.Join(dir, f.Name()))
+			if err != nil {
+				return nil, fmt.Errorf("failed to open sstable: %w", err)
+			}
+			sstables = append(sstables, *meta)
+		}
+	}
+
+	id := filepath.Base(dir)
+	if manifest != nil {
+		id = manifest.TabletID
+		if manifest.NextFileNum > maxFileNum+1 {
+			maxFileNum = manifest.NextFileNum - 1
+		}
+		// A crash between saving the manifest and checkpointing the log leaves
+		// the log behind; the manifest is authoritative.
+		if manifest.FlushedSeq > cl.FlushedSeq() {
+			if err := cl.MarkFlushed(manifest.FlushedSeq); err != nil {
+				return nil, fmt.Errorf("failed to checkpoint commit log: %w", err)
+			}
 		}
 	}
 
 	t := &Tablet{
+		ID:        id,
 		StartKey:  start,
 		EndKey:    end,
 		Dir:       dir,
 		MemTable:  NewMemTable(),
 		CommitLog: cl,
 		SSTables:  sstables,
+		Options:   opts,
+		Schema:    schema,
+		flushWake: make(chan struct{}, 1),
+		flushStop: make(chan struct{}),
 	}
+	t.nextFileNum.Store(maxFileNum + 1)
 
-	// Recovery: Replay WAL
-	mutations, err := cl.Recover()
+	// Recovery: Replay the WAL tail that was not flushed to SSTables yet
+	records, err := cl.Recover()
 	if err != nil {
 		return nil, fmt.Errorf("failed to recover WAL: %w", err)
 	}
 
 	// Replay mutations into MemTable (restore state)
-	for _, m := range mutations {
-		if err := t.MemTable.Apply(m); err != nil {
+	for _, rec := range records {
+		// Logs written before mutations were validated may hold requests that
+		// were rejected; their writers got an error, so they are dropped.
+		if err := rec.Mutation.Validate(); err != nil {
+			fmt.Printf("Skipping invalid mutation %d in %s: %v\n", rec.Seq, dir, err)
+			continue
+		}
+		if err := t.MemTable.Apply(rec.Mutation); err != nil {
 			return nil, fmt.Errorf("failed to replay mutation: %w", err)
 		}
+		t.MemTable.LastSeq = rec.Seq
+	}
+
+	// Record the recovered state, creating the manifest of a new or older tablet.
+	if err := t.saveManifestLocked(t.SSTables, cl.FlushedSeq()); err != nil {
+		return nil, err
+	}
+
+	t.flushWG.Add(1)
+	go t.flushLoop()
+
+	if opts.CompactionStrategy != nil {
+		t.compactions = newCompactionManager(t, opts)
+		t.compactions.start()
+		t.compactions.trigger()
 	}
 
 	return t, nil
 }
 
-// Mutate applies a mutation to the tablet.
+// memTables returns the active MemTable followed by the frozen ones, newest first.
+// It assumes the lock is held.
+func (t *Tablet) memTables() []*MemTable {
+	tables := make([]*MemTable, 0, len(t.Immutable)+1)
+	tables = append(tables, t.MemTable)
+	for i := len(t.Immutable) - 1; i >= 0; i-- {
+		tables = append(tables, t.Immutable[i])
+	}
+	return tables
+}
+
+// nextSSTablePath returns a new, unique SSTable path inside the tablet directory.
+func (t *Tablet) nextSSTablePath() string {
+	return filepath.Join(t.Dir, fmt.Sprintf("%06d.sst", t.nextFileNum.Add(1)-1))
+}
+
+// TriggerCompaction asks the background compaction manager to look for work now.
+// It does nothing if background compaction is disabled.
+func (t *Tablet) TriggerCompaction() {
+	if t.compactions != nil {
+		t.compactions.trigger()
+	}
+}
+
+// Mutate applies a mutation to the tablet with the durability from Options.
 // It verifies the row key is within range, writes to the WAL, and updates the MemTable.
 func (t *Tablet) Mutate(m *RowMutation) error {
+	return t.MutateWithOptions(m, MutateOptions{Durability: t.Options.Durability})
+}
+
+// MutateWithOptions applies a mutation with per-request options.
+// The mutation becomes visible to readers as soon as it is logged; with
+// DurabilitySync the call then waits for the log to be synced, which in
+// group-commit mode is shared with other concurrent writers.
+func (t *Tablet) MutateWithOptions(m *RowMutation, opts MutateOptions) error {
 	t.mu.Lock()
-	defer t.mu.Unlock()
+	seq, err := t.mutateLocked(m, opts.Durability)
+	t.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	return t.syncMutation(seq, opts.Durability)
+}
 
+// syncMutation waits for a logged mutation to be as durable as requested.
+// It is called without the lock so that concurrent writers can share an fsync.
+func (t *Tablet) syncMutation(seq uint64, durability Durability) error {
+	switch durability {
+	case DurabilitySync:
+		if err := t.CommitLog.Sync(seq); err != nil {
+			return fmt.Errorf("failed to sync WAL: %w", err)
+		}
+	case DurabilityAsync:
+		t.CommitLog.SyncAsync()
+	}
+	return nil
+}
+
+// mutateLocked logs and applies a mutation and returns its sequence number,
+// or 0 if it was not logged.
+// Logging and applying together keeps MemTables in sequence order, which
+// rotation and commit log truncation rely on. It assumes the lock is held.
+func (t *Tablet) mutateLocked(m *RowMutation, durability Durability) (uint64, error) {
+	if t.closed {
+		return 0, ErrTabletClosed
+	}
 	if !t.InRange(m.RowKey) {
-		return fmt.Errorf("key '%s' out of range [%s, %s)", m.RowKey, t.StartKey, t.EndKey)
+		return 0, fmt.Errorf("%w: '%s' not in [%s, %s)", ErrKeyOutOfRange, m.RowKey, t.StartKey, t.EndKey)
+	}
+
+	if err := t.Schema.validateMutation(m); err != nil {
+		return 0, err
 	}
 
+	// Pin server-assigned timestamps before logging so that replay is deterministic.
+	m.assignTimestamps(nowTimestamp())
+
 	// 1. Write to WAL (Durability)
-	if err := t.CommitLog.Append(m); err != nil {
-		return fmt.Errorf("failed to append to WAL: %w", err)
+	var seq uint64
+	if durability != DurabilityNone {
+		var err error
+		if seq, err = t.CommitLog.Append(m); err != nil {
+			return 0, fmt.Errorf("failed to append to WAL: %w", err)
+		}
 	}
 
 	// 2. Update MemTable (Visibility)
 	if err := t.MemTable.Apply(m); err != nil {
-		return fmt.Errorf("failed to apply to MemTable: %w", err)
+		return 0, fmt.Errorf("failed to apply to MemTable: %w", err)
+	}
+	if seq != 0 {
+		t.MemTable.LastSeq = seq
 	}
 
-	return nil
+	// 3. Minor compaction: freeze a full MemTable and let the flusher write it out.
+	if t.Options.MemTableFlushThreshold > 0 && t.MemTable.Size() >= t.Options.MemTableFlushThreshold {
+		t.rotateMemTable()
+	}
+
+	return seq, nil
 }
 
 // Read returns the latest value for a specific column.
 // It checks MemTable and all SSTables.
+// The row is merged across all sources first, so that a tombstone in any of them
+// shadows the versions it covers in the others. Versions collected by the
+// column family's GC rule are filtered out even if compaction has not removed them yet.
 func (t *Tablet) Read(rowKey, family, qualifier string) (*CellVersion, error) {
 	t.mu.RLock()
 	defer t.mu.RUnlock()
 
+	if t.closed {
+		return nil, ErrTabletClosed
+	}
 	if !t.InRange(rowKey) {
-		return nil, fmt.Errorf("key '%s' out of range [%s, %s)", rowKey, t.StartKey, t.EndKey)
+		return nil, fmt.Errorf("%w: '%s' not in [%s, %s)", ErrKeyOutOfRange, rowKey, t.StartKey, t.EndKey)
+	}
+
+	merged, err := t.mergeRowLocked(rowKey, func(r *SSTableReader) bool {
+		return r.MayContainColumn(rowKey, family, qualifier)
+	})
+	if err != nil {
+		return nil, err
 	}
+	return merged.Get(family, qualifier), nil
+}
 
-	var candidates []CellVersion
+// mergeRowLocked merges a row from every MemTable and SSTable, newest first,
+// so that tombstones shadow the older versions, then applies its GC rules. SSTables for which mayContain is
+// false are skipped. It assumes the lock is held.
+func (t *Tablet) mergeRowLocked(rowKey string, mayContain func(*SSTableReader) bool) (*Row, error) {
+	merged := NewRow(rowKey)
 
-	// 1. Check MemTable
-	if row := t.MemTable.Get(rowKey); row != nil {
-		if ver := row.Get(family, qualifier); ver != nil {
-			candidates = append(candidates, *ver)
+	// 1. Check MemTable, then the frozen ones waiting to be flushed
+	for _, m := range t.memTables() {
+		if row := m.Get(rowKey); row != nil {
+			mergeRows(merged, row.Clone())
 		}
 	}
 
-	// 2. Check SSTables (Expensive scan)
-	for _, sst := range t.SSTables {
-		// Optimization: We could keep Bloom Filters or Start/End keys per SSTable
-		rows, err := ReadSSTable(sst.Path)
+	// 2. Check SSTables (Expensive scan), newest first
+	for i := len(t.SSTables) - 1; i >= 0; i-- {
+		sst := t.SSTables[i]
+		// Skip files whose Bloom filter rules the row (or column) out.
+		if !mayContain(sst.reader) {
+			t.bloomMisses.Add(1)
+			continue
+		}
+		t.bloomHits.Add(1)
+
+		// The block index lets us read only the single block that could hold the row.
+		r, err := sst.reader.Get(rowKey)
 		if err != nil {
 			// Log error but maybe continue? failure is safer
 			return nil, fmt.Errorf("failed to read sstable %s: %w", sst.Path, err)
 		}
-		for _, r := range rows {
-			if r.Key == rowKey {
-				if ver := r.Get(family, qualifier); ver != nil {
-					candidates = append(candidates, *ver)
-				}
-				break // Found row in this SSTable
-			}
+		if r == nil {
+			t.bloomFalsePositives.Add(1)
+			continue
 		}
+		mergeRows(merged, r)
 	}
 
-	if len(candidates) == 0 {
-		return nil, nil // Not found
-	}
+	// 3. Drop garbage collected versions; mergeRows dropped the deleted ones.
+	merged.applyGC(t.Schema, time.Now().UnixNano())
+	return merged, nil
+}
 
-	// 3. Find latest
-	var best *CellVersion
-	for i := range candidates {
-		if best == nil || candidates[i].Timestamp > best.Timestamp {
-			best = &candidates[i]
-		}
+// BloomStats returns the Bloom filter counters accumulated by point reads.
+func (t *Tablet) BloomStats() BloomStats {
+	return BloomStats{
+		Hits:           t.bloomHits.Load(),
+		Misses:         t.bloomMisses.Load(),
+		FalsePositives: t.bloomFalsePositives.Load(),
 	}
-
-	return best, nil
 }
 
 // InRange checks if a key belongs to this tablet.
@@ -159,9 +396,51 @@ func (t *Tablet) InRange(key string) bool {
 	return true
 }
 
+// Size returns the approximate amount of data held by the tablet, in bytes:
+// MemTables (active and frozen) plus the on-disk size of every SSTable.
+func (t *Tablet) Size() int64 {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.sizeLocked()
+}
+
+func (t *Tablet) sizeLocked() int64 {
+	var size int64
+	for _, m := range t.memTables() {
+		size += m.Size()
+	}
+	for _, sst := range t.SSTables {
+		size += sst.Size
+	}
+	return size
+}
+
 // Close closes the tablet's resources.
+// It waits for running operations and background compactions to finish;
+// operations started afterwards fail with ErrTabletClosed. Calling it again
+// returns the result of the first call.
 func (t *Tablet) Close() error {
+	t.closeOnce.Do(func() { t.closeErr = t.close() })
+	return t.closeErr
+}
+
+func (t *Tablet) close() error {
+	t.mu.Lock()
+	t.closed = true
+	t.mu.Unlock()
+
+	// Frozen MemTables that were not flushed yet are still in the CommitLog.
+	close(t.flushStop)
+	t.flushWG.Wait()
+	if t.compactions != nil {
+		t.compactions.close()
+	}
+
 	t.mu.Lock()
 	defer t.mu.Unlock()
+
+	for _, sst := range t.SSTables {
+		sst.reader.unref()
+	}
 	return t.CommitLog.Close()
 }
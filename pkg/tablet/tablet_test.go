@@ -0,0 +1,235 @@
+package tablet
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+// testOptions disables background flushes and compactions, so tests decide
+// when data moves to SSTables.
+func testOptions() Options {
+	opts := DefaultOptions()
+	opts.MemTableFlushThreshold = 0
+	opts.CompactionStrategy = nil
+	return opts
+}
+
+// openTestTablet opens the tablet in dir, covering every key, with the "cf"
+// column family. It is closed when the test ends.
+func openTestTablet(t *testing.T, dir string, opts Options) *Tablet {
+	t.Helper()
+	tb, err := NewTabletWithOptions("", "", dir, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { tb.Close() })
+	if err := tb.SetColumnFamily(ColumnFamily{Name: "cf"}); err != nil {
+		t.Fatal(err)
+	}
+	return tb
+}
+
+// reopenTestTablet closes tb and opens its directory again.
+func reopenTestTablet(t *testing.T, tb *Tablet) *Tablet {
+	t.Helper()
+	if err := tb.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return openTestTablet(t, tb.Dir, tb.Options)
+}
+
+// flushTestTablet writes every MemTable of tb to SSTables.
+func flushTestTablet(t *testing.T, tb *Tablet) {
+	t.Helper()
+	tb.mu.Lock()
+	defer tb.mu.Unlock()
+	if err := tb.flushAllLocked(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func mustMutate(t *testing.T, tb *Tablet, m *RowMutation) {
+	t.Helper()
+	if err := tb.Mutate(m); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func set(key, qualifier string, ts int64, value string) *RowMutation {
+	m := NewRowMutation(key)
+	m.AddSet("cf", qualifier, ts, []byte(value))
+	return m
+}
+
+// readValue returns the latest value of cf:qualifier, or "" if there is none.
+func readValue(t *testing.T, tb *Tablet, key, qualifier string) string {
+	t.Helper()
+	v, err := tb.Read(key, "cf", qualifier)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v == nil {
+		return ""
+	}
+	return string(v.Value)
+}
+
+func TestMutateReadReopen(t *testing.T) {
+	tb := openTestTablet(t, t.TempDir(), testOptions())
+	mustMutate(t, tb, set("a", "q", 1, "a1"))
+	mustMutate(t, tb, set("b", "q", 1, "b1"))
+	flushTestTablet(t, tb)
+	mustMutate(t, tb, set("a", "q", 2, "a2"))
+
+	tb = reopenTestTablet(t, tb)
+	if got := readValue(t, tb, "a", "q"); got != "a2" {
+		t.Errorf("a = %q, want a2", got)
+	}
+	if got := readValue(t, tb, "b", "q"); got != "b1" {
+		t.Errorf("b = %q, want b1", got)
+	}
+	if got := readValue(t, tb, "c", "q"); got != "" {
+		t.Errorf("c = %q, want nothing", got)
+	}
+}
+
+func TestClose(t *testing.T) {
+	tb := openTestTablet(t, t.TempDir(), testOptions())
+	mustMutate(t, tb, set("a", "q", 1, "a1"))
+
+	// Close waits for operations holding the tablet.
+	tb.mu.RLock()
+	closed := make(chan error, 1)
+	go func() { closed <- tb.Close() }()
+	select {
+	case err := <-closed:
+		t.Fatalf("Close returned %v while a read was running", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+	tb.mu.RUnlock()
+	if err := <-closed; err != nil {
+		t.Fatal(err)
+	}
+	if err := tb.Close(); err != nil {
+		t.Errorf("second Close = %v", err)
+	}
+
+	ops := map[string]func() error{
+		"Mutate": func() error { return tb.Mutate(set("a", "q", 2, "a2")) },
+		"MutateRows": func() error {
+			return tb.MutateRows([]*RowMutation{set("a", "q", 2, "a2")}, MutateOptions{})[0]
+		},
+		"Read": func() error { _, err := tb.Read("a", "cf", "q"); return err },
+		"ReadVersions": func() error {
+			_, err := tb.ReadVersions("a", "cf", "q", ReadOptions{})
+			return err
+		},
+		"ReadRow": func() error { _, err := tb.ReadRow("a", ReadFilter{}); return err },
+		"Scan":    func() error { _, err := tb.Scan("", "", ScanOptions{}); return err },
+		"CheckAndMutate": func() error {
+			_, err := tb.CheckAndMutate("a", nil, set("a", "q", 2, "a2"), nil)
+			return err
+		},
+		"ReadModifyWrite": func() error {
+			rmw := NewReadModifyWriteRow("a")
+			rmw.AddAppend("cf", "q", []byte("x"))
+			_, err := tb.ReadModifyWrite(rmw)
+			return err
+		},
+		"SetColumnFamily": func() error { return tb.SetColumnFamily(ColumnFamily{Name: "cf2"}) },
+		"Split":           func() error { _, _, err := tb.Split(0); return err },
+	}
+	for name, op := range ops {
+		if err := op(); !errors.Is(err, ErrTabletClosed) {
+			t.Errorf("%s after Close = %v, want ErrTabletClosed", name, err)
+		}
+	}
+}
+
+func TestInvalidMutationIsNotLogged(t *testing.T) {
+	tests := []struct {
+		name string
+		op   MutationOperation
+		want string
+	}{
+		{"unknown type", MutationOperation{Type: 7, Family: "cf", Qualifier: "q"}, "unknown mutation type"},
+		{"inverted range", MutationOperation{Type: MutationDeleteTimeRange, Family: "cf", Qualifier: "q", Timestamp: 5, EndTimestamp: 5}, "end must be after start"},
+		{"negative start", MutationOperation{Type: MutationDeleteTimeRange, Family: "cf", Qualifier: "q", Timestamp: -1}, "must not be negative"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tb := openTestTablet(t, t.TempDir(), testOptions())
+			m := set("k", "q", 1, "v")
+			m.Ops = append(m.Ops, tt.op)
+			err := tb.Mutate(m)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("Mutate = %v, want an error containing %q", err, tt.want)
+			}
+			if got := readValue(t, tb, "k", "q"); got != "" {
+				t.Errorf("rejected mutation left k = %q visible", got)
+			}
+
+			tb = reopenTestTablet(t, tb)
+			if got := readValue(t, tb, "k", "q"); got != "" {
+				t.Errorf("k = %q after reopening, want nothing", got)
+			}
+		})
+	}
+}
+
+func TestRowApplyIsAllOrNothing(t *testing.T) {
+	row := NewRow("k")
+	m := set("k", "q", 1, "v")
+	m.Ops = append(m.Ops, MutationOperation{Type: 7})
+	if err := row.Apply(m); err == nil {
+		t.Fatal("Apply accepted an unknown mutation type")
+	}
+	if len(row.Columns) != 0 {
+		t.Errorf("Apply left %d columns behind", len(row.Columns))
+	}
+}
+
+func TestReplaySkipsInvalidLoggedMutation(t *testing.T) {
+	dir := t.TempDir()
+	tb := openTestTablet(t, dir, testOptions())
+	mustMutate(t, tb, set("k", "q", 1, "good"))
+	// A mutation logged before validation existed.
+	bad := set("k", "q", 2, "bad")
+	bad.Ops = append(bad.Ops, MutationOperation{Type: 7})
+	if _, err := tb.CommitLog.Append(bad); err != nil {
+		t.Fatal(err)
+	}
+
+	tb = reopenTestTablet(t, tb)
+	if got := readValue(t, tb, "k", "q"); got != "good" {
+		t.Errorf("k = %q after replay, want good", got)
+	}
+}
+
+func TestServerTimestampsAreWholeMicroseconds(t *testing.T) {
+	tb := openTestTablet(t, t.TempDir(), testOptions())
+	mustMutate(t, tb, set("k", "q", 0, "v"))
+	v, err := tb.Read("k", "cf", "q")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.Timestamp%timestampGranularity != 0 {
+		t.Errorf("assigned timestamp %d is not a whole microsecond", v.Timestamp)
+	}
+
+	// A version ahead of the clock is still superseded by a whole microsecond.
+	future := nowTimestamp() + int64(time.Hour) + 1
+	mustMutate(t, tb, set("k", "n", future, "\x00\x00\x00\x00\x00\x00\x00\x01"))
+	rmw := NewReadModifyWriteRow("k")
+	rmw.AddIncrement("cf", "n", 1)
+	row, err := tb.ReadModifyWrite(rmw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := row.Get("cf", "n").Timestamp
+	if got <= future || got%timestampGranularity != 0 {
+		t.Errorf("incremented timestamp %d, want a whole microsecond after %d", got, future)
+	}
+}
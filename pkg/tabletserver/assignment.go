@@ -0,0 +1,486 @@
+package tabletserver
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/Gourab-18/google_big_table/pkg/lockservice"
+	"github.com/Gourab-18/google_big_table/pkg/master"
+	"github.com/Gourab-18/google_big_table/pkg/tablet"
+)
+
+// DefaultHeartbeatInterval is the default Options.HeartbeatInterval.
+const DefaultHeartbeatInterval = 2 * time.Second
+
+// Options configures a TabletServer.
+type Options struct {
+	// MasterAddr is the address of the master. Empty runs the server standalone,
+	// unless Lock is set.
+	MasterAddr string
+	// Lock is the lock service. With it, the server holds a server lock for as
+	// long as it serves tablets, and finds the active master in the master
+	// lock rather than at MasterAddr.
+	Lock lockservice.Locker
+	// Addr is the address the server is reachable at. It is the server's ID at
+	// the master, and where the master sends load and unload commands.
+	Addr string
+	// HeartbeatInterval is how often the server heartbeats to the master.
+	HeartbeatInterval time.Duration
+	// LeaseTimeout must match the master's. A server that could not heartbeat
+	// for that long stops serving its tablets, since the master may have given
+	// them to another server already.
+	LeaseTimeout time.Duration
+
+	// Tablet is used to open every tablet.
+	Tablet tablet.Options
+}
+
+// DefaultOptions returns the options used by NewTabletServer.
+func DefaultOptions() Options {
+	return Options{
+		HeartbeatInterval: DefaultHeartbeatInterval,
+		LeaseTimeout:      master.DefaultLeaseTimeout,
+		Tablet:            tablet.DefaultOptions(),
+	}
+}
+
+// masterClient sends requests to the master.
+var masterClient = &http.Client{Timeout: 5 * time.Second}
+
+// Close stops heartbeating and closes every tablet.
+func (s *TabletServer) Close() error {
+	if s.heartbeatStop != nil {
+		close(s.heartbeatStop)
+		s.heartbeatWG.Wait()
+	}
+	return s.unloadAll()
+}
+
+// heartbeatLoop registers the server with the master, then heartbeats until Close.
+// Every heartbeat response carries the server's assignment, which is applied.
+// With a lock service, the server takes its server lock before registering,
+// and unloads everything and starts over if it loses it.
+func (s *TabletServer) heartbeatLoop() {
+	defer s.heartbeatWG.Done()
+
+	interval := s.Options.HeartbeatInterval
+	if interval <= 0 {
+		interval = DefaultHeartbeatInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var session *lockservice.Session
+	var lost <-chan struct{} // Closed when the server lock is lost
+	registered := false
+	lastAck := time.Now()
+	for {
+		if s.Options.Lock != nil && session == nil {
+			var err error
+			if session, err = s.lockServer(); err != nil {
+				fmt.Printf("Failed to take server lock: %v\n", err)
+			} else {
+				lost = session.Done()
+			}
+		}
+		if !registered && (s.Options.Lock == nil || session != nil) {
+			if err := s.register(); err != nil {
+				fmt.Printf("Failed to register with master: %v\n", err)
+			} else {
+				registered = true
+			}
+		}
+		if registered {
+			// The master renews the lease when it receives the heartbeat, so ours
+			// starts no later than theirs if measured from before sending it.
+			sentAt := time.Now()
+			assigned, err := s.heartbeat()
+			switch {
+			case err == errUnknownServer:
+				// Our lease expired and our tablets went elsewhere: stop serving them.
+				fmt.Printf("Master no longer knows server %s; unloading tablets and registering again\n", s.Options.Addr)
+				s.unloadAll()
+				registered = false
+			case err != nil:
+				fmt.Printf("Heartbeat failed: %v\n", err)
+			default:
+				lastAck = sentAt
+				s.reconcile(assigned)
+			}
+		}
+
+		// Without a heartbeat for a whole lease, the master may consider us dead
+		// and reassign our tablets. Give them up first, allowing for one tick.
+		if s.Options.LeaseTimeout > 0 && time.Since(lastAck) > s.Options.LeaseTimeout-interval && s.tabletCount() > 0 {
+			fmt.Printf("No heartbeat acknowledged for %v; unloading tablets\n", s.Options.LeaseTimeout)
+			s.unloadAll()
+		}
+
+		select {
+		case <-s.heartbeatStop:
+			if session != nil {
+				session.Close()
+			}
+			return
+		case <-lost:
+			// Losing the lock means death: the master reassigns our tablets.
+			fmt.Printf("Lost server lock; unloading tablets\n")
+			s.unloadAll()
+			session.Close()
+			session, lost, registered = nil, nil, false
+		case <-ticker.C:
+		}
+	}
+}
+
+// lockServer opens a lock service session and takes the server lock in it.
+func (s *TabletServer) lockServer() (*lockservice.Session, error) {
+	session, err := lockservice.NewSession(s.Options.Lock)
+	if err != nil {
+		return nil, err
+	}
+	path := master.ServerLockPath(s.Options.Addr)
+	ok, err := session.Acquire(path, []byte(s.Options.Addr))
+	if err == nil && !ok {
+		// Likely our previous incarnation, until its session expires.
+		err = fmt.Errorf("%s is held by another session", path)
+	}
+	if err != nil {
+		session.Close()
+		return nil, err
+	}
+	return session, nil
+}
+
+// masterAddr returns the address of the active master: the one in the master
+// lock with a lock service, Options.MasterAddr otherwise.
+func (s *TabletServer) masterAddr() (string, error) {
+	if s.Options.Lock == nil {
+		return s.Options.MasterAddr, nil
+	}
+	f, err := s.Options.Lock.Get(master.MasterLockPath)
+	if err != nil {
+		return "", err
+	}
+	if f == nil {
+		return "", fmt.Errorf("no active master")
+	}
+	return string(f.Contents), nil
+}
+
+// errUnknownServer is returned by heartbeat when the master does not know the server.
+var errUnknownServer = fmt.Errorf("server unknown to master")
+
+// register announces the server to the master.
+func (s *TabletServer) register() error {
+	addr, err := s.masterAddr()
+	if err != nil {
+		return err
+	}
+	u := masterURL(addr) + "/register?id=" + url.QueryEscape(s.Options.Addr)
+	resp, err := masterClient.Post(u, "", nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("master returned %s", resp.Status)
+	}
+	fmt.Printf("Registered with master %s as %s\n", addr, s.Options.Addr)
+	return nil
+}
+
+// heartbeat renews the server's lease and returns its assignment.
+func (s *TabletServer) heartbeat() ([]master.Assignment, error) {
+	hb := master.HeartbeatRequest{
+		ServerID:       s.Options.Addr,
+		Tablets:        []string{},
+		ColumnFamilies: make(map[string][]tablet.ColumnFamily),
+	}
+	s.mu.RLock()
+	for _, t := range s.Tablets {
+		hb.Tablets = append(hb.Tablets, t.ID)
+		hb.Load += t.Size()
+		hb.ColumnFamilies[t.ID] = t.ColumnFamilies()
+	}
+	s.mu.RUnlock()
+
+	body, err := json.Marshal(hb)
+	if err != nil {
+		return nil, err
+	}
+	addr, err := s.masterAddr()
+	if err != nil {
+		return nil, err
+	}
+	resp, err := masterClient.Post(masterURL(addr)+"/heartbeat", "application/json", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, errUnknownServer
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("master returned %s", resp.Status)
+	}
+
+	var hbResp master.HeartbeatResponse
+	if err := json.NewDecoder(resp.Body).Decode(&hbResp); err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	s.masterEpoch = hbResp.Epoch
+	s.mu.Unlock()
+	return hbResp.Tablets, nil
+}
+
+// reconcile loads the assigned tablets the server does not serve yet, and
+// unloads the tablets it serves that are no longer assigned to it.
+func (s *TabletServer) reconcile(assigned []master.Assignment) {
+	want := make(map[string]bool)
+	for _, a := range assigned {
+		want[a.TabletID] = true
+		if err := s.LoadTablet(a); err != nil {
+			fmt.Printf("Failed to load tablet %s: %v\n", a.TabletID, err)
+		}
+	}
+
+	s.mu.RLock()
+	var stale []string
+	for _, t := range s.Tablets {
+		if !want[t.ID] {
+			stale = append(stale, t.ID)
+		}
+	}
+	s.mu.RUnlock()
+
+	for _, id := range stale {
+		if err := s.UnloadTablet(id); err != nil {
+			fmt.Printf("Failed to unload tablet %s: %v\n", id, err)
+		}
+	}
+}
+
+// LoadTablet opens a tablet and starts serving it as part of its table, with
+// the column families of the assignment, if it has any. The tablet lives in
+// RootDir/<TabletID>: an existing tablet is reopened from its manifest and
+// commit log, a new one is created with the given range.
+// Loading a tablet that is already served only applies the column families.
+func (s *TabletServer) LoadTablet(a master.Assignment) error {
+	if a.TabletID == "" || strings.ContainsAny(a.TabletID, `/\`) || a.TabletID == "." || a.TabletID == ".." {
+		return fmt.Errorf("invalid tablet id %q", a.TabletID)
+	}
+	table := a.Table
+	if table == "" {
+		table = master.DefaultTable
+	}
+
+	s.loadMu.Lock()
+	defer s.loadMu.Unlock()
+
+	var t *tablet.Tablet
+	s.mu.RLock()
+	for _, cand := range s.Tablets {
+		if cand.ID == a.TabletID {
+			t = cand
+			break
+		}
+	}
+	s.mu.RUnlock()
+	if t != nil {
+		return syncColumnFamilies(t, a.ColumnFamilies)
+	}
+
+	// This reopens the tablet from its manifest, which must hold the same range,
+	// or creates it if it has none yet.
+	dir := filepath.Join(s.RootDir, a.TabletID)
+	t, err := tablet.NewTabletWithOptions(a.StartKey, a.EndKey, dir, s.Options.Tablet)
+	if err != nil {
+		return err
+	}
+	// Apply the families before serving, so writes never see a stale schema.
+	if err := syncColumnFamilies(t, a.ColumnFamilies); err != nil {
+		t.Close()
+		return err
+	}
+
+	s.mu.Lock()
+	s.Tablets = append(s.Tablets, t)
+	s.tables[t.ID] = table
+	s.mu.Unlock()
+	fmt.Printf("Loaded tablet %s of table %s [%s, %s)\n", t.ID, table, t.StartKey, t.EndKey)
+	return nil
+}
+
+// syncColumnFamilies makes the schema of a tablet declare exactly families.
+// Nil families, which the master does not know yet, leave it as it is.
+func syncColumnFamilies(t *tablet.Tablet, families []tablet.ColumnFamily) error {
+	if families == nil {
+		return nil
+	}
+	// Schemas are replaced, never modified, so this one stays as it is.
+	schema := t.Schema
+	want := make(map[string]bool)
+	for _, f := range families {
+		want[f.Name] = true
+		if cur, ok := schema.Families[f.Name]; ok && reflect.DeepEqual(cur, f) {
+			continue
+		}
+		if err := t.SetColumnFamily(f); err != nil {
+			return err
+		}
+	}
+	for name := range schema.Families {
+		if !want[name] {
+			if err := t.DeleteColumnFamily(name); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// UnloadTablet stops serving a tablet and closes it, so another server can open it.
+// Unloading a tablet that is not served does nothing.
+func (s *TabletServer) UnloadTablet(id string) error {
+	s.loadMu.Lock()
+	defer s.loadMu.Unlock()
+
+	// Stop routing requests to the tablet before closing it.
+	s.mu.Lock()
+	var t *tablet.Tablet
+	for i, cand := range s.Tablets {
+		if cand.ID == id {
+			t = cand
+			s.Tablets = append(s.Tablets[:i:i], s.Tablets[i+1:]...)
+			delete(s.tables, id)
+			break
+		}
+	}
+	s.mu.Unlock()
+	if t == nil {
+		return nil
+	}
+
+	fmt.Printf("Unloading tablet %s\n", id)
+	return t.Close()
+}
+
+// unloadAll unloads every tablet.
+func (s *TabletServer) unloadAll() error {
+	s.mu.RLock()
+	ids := make([]string, len(s.Tablets))
+	for i, t := range s.Tablets {
+		ids[i] = t.ID
+	}
+	s.mu.RUnlock()
+
+	var firstErr error
+	for _, id := range ids {
+		if err := s.UnloadTablet(id); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+func (s *TabletServer) tabletCount() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.Tablets)
+}
+
+// checkMaster returns an error unless a command was sent by the active master:
+// with a lock service, the holder of the master lock, and otherwise the master
+// that answered the last heartbeat. Commands from a deposed master, which may
+// not know yet that another one took over, are refused.
+func (s *TabletServer) checkMaster(r *http.Request) error {
+	epoch := r.Header.Get(master.EpochHeader)
+	if epoch == "" {
+		return fmt.Errorf("missing %s", master.EpochHeader)
+	}
+	var active string
+	if s.Options.Lock != nil {
+		f, err := s.Options.Lock.Get(master.MasterLockPath)
+		if err != nil {
+			return fmt.Errorf("failed to look up the active master: %w", err)
+		}
+		if f != nil {
+			active = f.Session
+		}
+	} else {
+		s.mu.RLock()
+		active = s.masterEpoch
+		s.mu.RUnlock()
+	}
+	if epoch != active {
+		return fmt.Errorf("command from master epoch %q, but the active master is %q", epoch, active)
+	}
+	return nil
+}
+
+// HandleLoad serves a tablet, or updates the column families of one, on the
+// master's command. It takes a master.Assignment, and refuses commands from
+// any master but the active one.
+func (s *TabletServer) HandleLoad(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if err := s.checkMaster(r); err != nil {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	var a master.Assignment
+	if err := json.NewDecoder(r.Body).Decode(&a); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err := s.LoadTablet(a); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// HandleUnload stops serving a tablet on the master's command. It takes the
+// tablet in the "id" query parameter, and refuses commands from any master but
+// the active one.
+func (s *TabletServer) HandleUnload(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if err := s.checkMaster(r); err != nil {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	id := r.URL.Query().Get("id")
+	if id == "" {
+		http.Error(w, "missing id", http.StatusBadRequest)
+		return
+	}
+	if err := s.UnloadTablet(id); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// masterURL turns a "host:port" address into an HTTP URL; URLs are kept as they are.
+func masterURL(addr string) string {
+	if strings.Contains(addr, "://") {
+		return strings.TrimSuffix(addr, "/")
+	}
+	return "http://" + addr
+}
@@ -0,0 +1,328 @@
+package tabletserver
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Gourab-18/google_big_table/pkg/lockservice"
+	"github.com/Gourab-18/google_big_table/pkg/master"
+	"github.com/Gourab-18/google_big_table/pkg/tablet"
+)
+
+// partitionedLocker is a Locker whose keep-alives fail while cut off.
+type partitionedLocker struct {
+	lockservice.Locker
+	cut atomic.Bool
+}
+
+func (l *partitionedLocker) KeepAlive(session string) error {
+	if l.cut.Load() {
+		return errors.New("network partition")
+	}
+	return l.Locker.KeepAlive(session)
+}
+
+// fakeMasterEpoch is the epoch of every fakeMaster.
+const fakeMasterEpoch = "epoch-1"
+
+// fakeMaster assigns the root tablet to every server that heartbeats, and
+// refuses every request while down.
+type fakeMaster struct {
+	URL       string
+	down      atomic.Bool
+	registers atomic.Int32
+}
+
+func newFakeMaster(t *testing.T) *fakeMaster {
+	t.Helper()
+	fm := &fakeMaster{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if fm.down.Load() {
+			http.Error(w, "down", http.StatusServiceUnavailable)
+			return
+		}
+		switch r.URL.Path {
+		case "/register":
+			fm.registers.Add(1)
+		case "/heartbeat":
+			var hb master.HeartbeatRequest
+			if err := json.NewDecoder(r.Body).Decode(&hb); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			json.NewEncoder(w).Encode(master.HeartbeatResponse{Epoch: fakeMasterEpoch, Tablets: []master.Assignment{
+				{TabletLocation: master.TabletLocation{Table: master.DefaultTable, TabletID: "root", ServerID: hb.ServerID}},
+			}})
+		}
+	}))
+	t.Cleanup(srv.Close)
+	fm.URL = srv.URL
+	return fm
+}
+
+// waitFor polls cond until it holds.
+func waitFor(t *testing.T, what string, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting until %s", what)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestServerUnloadsTabletsWhenItLosesItsLock(t *testing.T) {
+	svc, err := lockservice.NewService(lockservice.Options{SessionTTL: 300 * time.Millisecond})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer svc.Close()
+	fm := newFakeMaster(t)
+	ms, err := lockservice.NewSession(svc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ms.Close()
+	if ok, err := ms.Acquire(master.MasterLockPath, []byte(fm.URL)); err != nil || !ok {
+		t.Fatalf("taking the master lock = %v, %v", ok, err)
+	}
+
+	l := &partitionedLocker{Locker: svc}
+	opts := testOptions()
+	opts.Lock = l
+	opts.Addr = "ts1"
+	opts.HeartbeatInterval = 10 * time.Millisecond
+	opts.LeaseTimeout = 0 // Only the lock decides.
+	s, err := NewTabletServerWithOptions(t.TempDir(), opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	waitFor(t, "the server loads its tablet", func() bool { return s.tabletCount() == 1 })
+	lock, _ := svc.Get(master.ServerLockPath("ts1"))
+	if lock == nil {
+		t.Fatal("serving without a server lock")
+	}
+
+	// Cut off from the lock service, the server loses its lock while the
+	// master is unreachable, so it cannot learn it is dead from heartbeats.
+	fm.down.Store(true)
+	l.cut.Store(true)
+	waitFor(t, "the server unloads its tablet", func() bool { return s.tabletCount() == 0 })
+
+	// Back in touch, it takes a new lock and registers again.
+	registers := fm.registers.Load()
+	l.cut.Store(false)
+	fm.down.Store(false)
+	waitFor(t, "the server serves again", func() bool { return s.tabletCount() == 1 })
+	if fm.registers.Load() == registers {
+		t.Error("the server did not register again")
+	}
+	if f, _ := svc.Get(master.ServerLockPath("ts1")); f == nil || f.Session == lock.Session {
+		t.Errorf("server lock = %+v, want one in a new session", f)
+	}
+}
+
+func TestLoadTabletKeepsFamiliesUntilKnown(t *testing.T) {
+	s := newTestServer(t)
+	root := s.Tablets[0]
+	loc := master.TabletLocation{Table: master.DefaultTable, TabletID: root.ID}
+	families := func() []string { return familyNames(root.ColumnFamilies()) }
+
+	if err := s.LoadTablet(master.Assignment{TabletLocation: loc}); err != nil {
+		t.Fatal(err)
+	}
+	if got := families(); !reflect.DeepEqual(got, []string{"cf"}) {
+		t.Errorf("families after a load without families = %v, want cf", got)
+	}
+	if err := s.LoadTablet(master.Assignment{TabletLocation: loc, ColumnFamilies: []tablet.ColumnFamily{{Name: "cf2"}}}); err != nil {
+		t.Fatal(err)
+	}
+	if got := families(); !reflect.DeepEqual(got, []string{"cf2"}) {
+		t.Errorf("families = %v, want the assigned cf2", got)
+	}
+	if err := s.LoadTablet(master.Assignment{TabletLocation: loc, ColumnFamilies: []tablet.ColumnFamily{}}); err != nil {
+		t.Fatal(err)
+	}
+	if got := families(); len(got) != 0 {
+		t.Errorf("families = %v, want none", got)
+	}
+}
+
+// familyNames returns the names of families, in order.
+func familyNames(families []tablet.ColumnFamily) []string {
+	names := make([]string, 0, len(families))
+	for _, f := range families {
+		names = append(names, f.Name)
+	}
+	return names
+}
+
+func TestMasterTakesFamiliesFromExistingTablets(t *testing.T) {
+	// A root tablet served before the master kept its tables.
+	root := t.TempDir()
+	tb, err := tablet.NewTabletWithOptions("", "", filepath.Join(root, "root"), testOptions().Tablet)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = tb.SetColumnFamily(tablet.ColumnFamily{Name: "cf", GCRule: tablet.MaxVersionsGCRule(1)})
+	if cerr := tb.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m := master.NewMasterWithOptions(master.DefaultOptions())
+	defer m.Close()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/register", m.HandleRegister)
+	mux.HandleFunc("/heartbeat", m.HandleHeartbeat)
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	opts := testOptions()
+	opts.MasterAddr = srv.URL
+	opts.Addr = "127.0.0.1:1"
+	opts.HeartbeatInterval = 10 * time.Millisecond
+	s, err := NewTabletServerWithOptions(root, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	tableFamilies := func() []string {
+		info, err := m.GetTable(master.DefaultTable)
+		if err != nil || info.ColumnFamilies == nil {
+			return nil
+		}
+		return familyNames(info.ColumnFamilies)
+	}
+	waitFor(t, "the master knows the families", func() bool { return tableFamilies() != nil })
+	if got := tableFamilies(); !reflect.DeepEqual(got, []string{"cf"}) {
+		t.Errorf("table families = %v, want the tablet's cf", got)
+	}
+	if got := familyNames(s.Tablets[0].ColumnFamilies()); !reflect.DeepEqual(got, []string{"cf"}) {
+		t.Fatalf("tablet families = %v, want cf kept", got)
+	}
+
+	// From then on the master manages them.
+	if _, err := m.ModifyColumnFamilies(master.DefaultTable, []master.ColumnFamilyModification{{Name: "cf2", Create: &tablet.GCRule{}}}); err != nil {
+		t.Fatal(err)
+	}
+	waitFor(t, "the tablet gets the new family", func() bool {
+		s.mu.RLock()
+		defer s.mu.RUnlock()
+		return len(s.Tablets) == 1 && reflect.DeepEqual(familyNames(s.Tablets[0].ColumnFamilies()), []string{"cf", "cf2"})
+	})
+}
+
+// sendCommand posts a command to handler as the master of epoch, if any.
+func sendCommand(handler http.HandlerFunc, path, epoch, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+	if epoch != "" {
+		req.Header.Set(master.EpochHeader, epoch)
+	}
+	rec := httptest.NewRecorder()
+	handler(rec, req)
+	return rec
+}
+
+// checkCommands checks that load and unload commands are only followed when
+// they come from the master of epoch active.
+func checkCommands(t *testing.T, s *TabletServer, active string, stale ...string) {
+	t.Helper()
+	load := `{"Table": "default", "TabletID": "t2", "StartKey": "", "EndKey": ""}`
+	for _, epoch := range append(stale, "") {
+		if rec := sendCommand(s.HandleLoad, "/load", epoch, load); rec.Code != http.StatusForbidden {
+			t.Errorf("load from epoch %q = %d (%s), want 403", epoch, rec.Code, rec.Body)
+		}
+	}
+	if n := s.tabletCount(); n != 1 {
+		t.Fatalf("serving %d tablets after refused loads, want 1", n)
+	}
+	if rec := sendCommand(s.HandleLoad, "/load", active, load); rec.Code != http.StatusOK {
+		t.Fatalf("load from the active master = %d (%s), want 200", rec.Code, rec.Body)
+	}
+	for _, epoch := range append(stale, "") {
+		if rec := sendCommand(s.HandleUnload, "/unload?id=t2", epoch, ""); rec.Code != http.StatusForbidden {
+			t.Errorf("unload from epoch %q = %d (%s), want 403", epoch, rec.Code, rec.Body)
+		}
+	}
+	if n := s.tabletCount(); n != 2 {
+		t.Fatalf("serving %d tablets after refused unloads, want 2", n)
+	}
+	if rec := sendCommand(s.HandleUnload, "/unload?id=t2", active, ""); rec.Code != http.StatusOK {
+		t.Fatalf("unload from the active master = %d (%s), want 200", rec.Code, rec.Body)
+	}
+	if n := s.tabletCount(); n != 1 {
+		t.Errorf("serving %d tablets after the unload, want 1", n)
+	}
+}
+
+func TestCommandsOnlyFromTheMasterOfTheLastHeartbeat(t *testing.T) {
+	fm := newFakeMaster(t)
+	opts := testOptions()
+	opts.MasterAddr = fm.URL
+	opts.Addr = "ts1"
+	opts.HeartbeatInterval = time.Hour // Only the first heartbeat, which loads root.
+	opts.LeaseTimeout = 0
+	s, err := NewTabletServerWithOptions(t.TempDir(), opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	waitFor(t, "the server loads its tablet", func() bool { return s.tabletCount() == 1 })
+
+	checkCommands(t, s, fakeMasterEpoch, "epoch-0")
+}
+
+func TestCommandsOnlyFromTheMasterLockHolder(t *testing.T) {
+	svc, err := lockservice.NewService(lockservice.Options{SessionTTL: time.Minute})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer svc.Close()
+	fm := newFakeMaster(t)
+	deposed, err := lockservice.NewSession(svc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := deposed.Acquire(master.MasterLockPath, []byte(fm.URL)); err != nil || !ok {
+		t.Fatalf("taking the master lock = %v, %v", ok, err)
+	}
+
+	opts := testOptions()
+	opts.Lock = svc
+	opts.Addr = "ts1"
+	opts.HeartbeatInterval = time.Hour
+	opts.LeaseTimeout = 0
+	s, err := NewTabletServerWithOptions(t.TempDir(), opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	waitFor(t, "the server loads its tablet", func() bool { return s.tabletCount() == 1 })
+
+	// Another master takes over. The server has not heartbeated to it yet,
+	// but the lock tells it which master is active.
+	deposed.Close()
+	active, err := lockservice.NewSession(svc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer active.Close()
+	if ok, err := active.Acquire(master.MasterLockPath, []byte(fm.URL)); err != nil || !ok {
+		t.Fatalf("taking the master lock = %v, %v", ok, err)
+	}
+	checkCommands(t, s, active.ID, deposed.ID, fakeMasterEpoch)
+}
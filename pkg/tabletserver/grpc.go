@@ -0,0 +1,647 @@
+package tabletserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/Gourab-18/google_big_table/pkg/bigtablepb"
+	"github.com/Gourab-18/google_big_table/pkg/master"
+	"github.com/Gourab-18/google_big_table/pkg/tablet"
+)
+
+// readRowsResponseBytes is roughly how much cell data a ReadRows response carries.
+// Larger values are split into chunks of this size.
+const readRowsResponseBytes = 1 << 20
+
+// NewGRPCServer creates a gRPC server exposing the tablets through the Cloud
+// Bigtable v2 data API (google.bigtable.v2.Bigtable), so that Bigtable client
+// libraries can talk to the tablet server. Requests address tables by the last
+// segment of their table name ("projects/p/instances/i/tables/t"); an empty
+// name means the default table.
+func (s *TabletServer) NewGRPCServer(opts ...grpc.ServerOption) *grpc.Server {
+	srv := grpc.NewServer(opts...)
+	bigtablepb.RegisterBigtableServer(srv, &bigtableService{s: s})
+	return srv
+}
+
+// ServeGRPC starts the gRPC server.
+func (s *TabletServer) ServeGRPC(addr string) error {
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	return s.NewGRPCServer().Serve(lis)
+}
+
+// tableID returns the table addressed by a Bigtable table name.
+func tableID(name string) string {
+	if name == "" {
+		return master.DefaultTable
+	}
+	if i := strings.LastIndex(name, "/tables/"); i >= 0 {
+		return name[i+len("/tables/"):]
+	}
+	return name
+}
+
+// bigtableService implements bigtablepb.BigtableServer on top of a TabletServer.
+// The change stream and PingAndWarm methods are left unimplemented.
+type bigtableService struct {
+	bigtablepb.UnimplementedBigtableServer
+	s *TabletServer
+}
+
+// readRowsReversedField is the number of ReadRowsRequest.reversed, which the
+// generated messages predate. It arrives as an unknown field.
+const readRowsReversedField = 7
+
+// hasReversed reports whether a ReadRows request asks for a reversed scan.
+func hasReversed(req *bigtablepb.ReadRowsRequest) bool {
+	b := req.ProtoReflect().GetUnknown()
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return false
+		}
+		b = b[n:]
+		if num == readRowsReversedField && typ == protowire.VarintType {
+			v, n := protowire.ConsumeVarint(b)
+			return n >= 0 && v != 0
+		}
+		n = protowire.ConsumeFieldValue(num, typ, b)
+		if n < 0 {
+			return false
+		}
+		b = b[n:]
+	}
+	return false
+}
+
+// ReadRows streams the rows of the row set in key order, as cell chunks.
+// A row set with keys that no tablet of the server covers fails with NotFound.
+func (b *bigtableService) ReadRows(req *bigtablepb.ReadRowsRequest, stream bigtablepb.Bigtable_ReadRowsServer) error {
+	if hasReversed(req) {
+		return status.Error(codes.Unimplemented, "reversed scans are not supported")
+	}
+	if req.RowsLimit < 0 {
+		return status.Errorf(codes.InvalidArgument, "negative rows limit %d", req.RowsLimit)
+	}
+	filter, err := toRowFilter(req.Filter)
+	if err != nil {
+		return err
+	}
+	if filter != nil {
+		if err := filter.Validate(); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
+		}
+	}
+
+	// Like /scan, refuse row sets this server only partly serves rather than
+	// silently skipping rows held elsewhere. Nothing is sent before the check.
+	ranges := toKeyRanges(req.Rows)
+	tablets := make([][]*tablet.Tablet, len(ranges))
+	for i, kr := range ranges {
+		tablets[i] = b.s.tabletsInRange(tableID(req.TableName), kr.start, kr.end)
+		if key, ok := firstGap(tablets[i], kr.start, kr.end); ok {
+			return status.Errorf(codes.NotFound, "no tablet found for key '%s'", key)
+		}
+	}
+
+	ctx := stream.Context()
+	w := &chunkWriter{stream: stream}
+	var sent int64
+	for i, kr := range ranges {
+		for _, t := range tablets[i] {
+			it, err := t.Scan(kr.start, kr.end, tablet.ScanOptions{Filter: filter})
+			if err != nil {
+				return grpcError(err)
+			}
+			for it.Next() {
+				if err := ctx.Err(); err != nil {
+					it.Close()
+					return status.FromContextError(err).Err()
+				}
+				if err := w.writeRow(it.Row()); err != nil {
+					it.Close()
+					return err
+				}
+				sent++
+				if req.RowsLimit > 0 && sent == req.RowsLimit {
+					it.Close()
+					return w.flush()
+				}
+			}
+			err = it.Err()
+			it.Close()
+			if err != nil {
+				return grpcError(err)
+			}
+		}
+	}
+	return w.flush()
+}
+
+// chunkWriter turns rows into ReadRows responses of about readRowsResponseBytes.
+// A response can end in the middle of a row.
+type chunkWriter struct {
+	stream bigtablepb.Bigtable_ReadRowsServer
+	chunks []*bigtablepb.ReadRowsResponse_CellChunk
+	size   int
+}
+
+// writeRow queues the chunks of a row: cells ordered by family, qualifier and
+// timestamp descending, with the last chunk committing the row.
+func (w *chunkWriter) writeRow(row *tablet.Row) error {
+	cols := make([]*tablet.Column, 0, len(row.Columns))
+	for _, col := range row.Columns {
+		cols = append(cols, col)
+	}
+	sort.Slice(cols, func(i, j int) bool {
+		if cols[i].Family != cols[j].Family {
+			return cols[i].Family < cols[j].Family
+		}
+		return cols[i].Qualifier < cols[j].Qualifier
+	})
+
+	first := true
+	lastFamily := ""
+	for _, col := range cols {
+		for vi, v := range col.Versions {
+			chunk := &bigtablepb.ReadRowsResponse_CellChunk{TimestampMicros: v.Timestamp / 1000}
+			if first {
+				chunk.RowKey = []byte(row.Key)
+			}
+			if first || col.Family != lastFamily {
+				chunk.FamilyName = wrapperspb.String(col.Family)
+			}
+			if vi == 0 {
+				chunk.Qualifier = wrapperspb.Bytes([]byte(col.Qualifier))
+			}
+			first, lastFamily = false, col.Family
+
+			// Large values are split; every piece but the last carries the full size.
+			value := v.Value
+			for len(value) > readRowsResponseBytes {
+				chunk.Value, chunk.ValueSize = value[:readRowsResponseBytes], int32(len(v.Value))
+				if err := w.add(chunk); err != nil {
+					return err
+				}
+				value = value[readRowsResponseBytes:]
+				chunk = &bigtablepb.ReadRowsResponse_CellChunk{}
+			}
+			chunk.Value = value
+			if err := w.add(chunk); err != nil {
+				return err
+			}
+		}
+	}
+
+	// add never sends the chunk it queues, so the last one is still pending.
+	w.chunks[len(w.chunks)-1].RowStatus = &bigtablepb.ReadRowsResponse_CellChunk_CommitRow{CommitRow: true}
+	return nil
+}
+
+// add queues a chunk, sending the pending ones first if the response is full.
+func (w *chunkWriter) add(chunk *bigtablepb.ReadRowsResponse_CellChunk) error {
+	n := len(chunk.RowKey) + len(chunk.Value) + 32
+	if chunk.Qualifier != nil {
+		n += len(chunk.Qualifier.Value)
+	}
+	if w.size+n > readRowsResponseBytes {
+		if err := w.flush(); err != nil {
+			return err
+		}
+	}
+	w.chunks = append(w.chunks, chunk)
+	w.size += n
+	return nil
+}
+
+// flush sends the pending chunks, if any.
+func (w *chunkWriter) flush() error {
+	if len(w.chunks) == 0 {
+		return nil
+	}
+	if err := w.stream.Send(&bigtablepb.ReadRowsResponse{Chunks: w.chunks}); err != nil {
+		return err
+	}
+	w.chunks, w.size = nil, 0
+	return nil
+}
+
+// keyRange is the range of row keys [start, end). An empty end means no bound.
+type keyRange struct {
+	start, end string
+}
+
+// toKeyRanges converts a row set to sorted, disjoint key ranges.
+// A nil or empty row set is the whole table.
+func toKeyRanges(set *bigtablepb.RowSet) []keyRange {
+	if set == nil || (len(set.RowKeys) == 0 && len(set.RowRanges) == 0) {
+		return []keyRange{{}}
+	}
+
+	var ranges []keyRange
+	for _, k := range set.RowKeys {
+		ranges = append(ranges, keyRange{string(k), string(k) + "\x00"})
+	}
+	for _, r := range set.RowRanges {
+		var kr keyRange
+		switch k := r.StartKey.(type) {
+		case *bigtablepb.RowRange_StartKeyClosed:
+			kr.start = string(k.StartKeyClosed)
+		case *bigtablepb.RowRange_StartKeyOpen:
+			kr.start = string(k.StartKeyOpen) + "\x00"
+		}
+		switch k := r.EndKey.(type) {
+		case *bigtablepb.RowRange_EndKeyOpen:
+			if len(k.EndKeyOpen) == 0 {
+				continue // Nothing sorts before the empty key.
+			}
+			kr.end = string(k.EndKeyOpen)
+		case *bigtablepb.RowRange_EndKeyClosed:
+			kr.end = string(k.EndKeyClosed) + "\x00"
+		}
+		if kr.end != "" && kr.start >= kr.end {
+			continue
+		}
+		ranges = append(ranges, kr)
+	}
+	sort.Slice(ranges, func(i, j int) bool { return ranges[i].start < ranges[j].start })
+
+	// Merge overlapping ranges, so every row is read once and in order.
+	var merged []keyRange
+	for _, kr := range ranges {
+		if n := len(merged); n > 0 {
+			last := &merged[n-1]
+			if last.end == "" || kr.start <= last.end {
+				if last.end != "" && (kr.end == "" || kr.end > last.end) {
+					last.end = kr.end
+				}
+				continue
+			}
+		}
+		merged = append(merged, kr)
+	}
+	return merged
+}
+
+// SampleRowKeys returns the end key of every tablet with the amount of data
+// stored before it. The last sample has an empty key: the end of the table.
+func (b *bigtableService) SampleRowKeys(req *bigtablepb.SampleRowKeysRequest, stream bigtablepb.Bigtable_SampleRowKeysServer) error {
+	var offset int64
+	for _, t := range b.s.tabletsInRange(tableID(req.TableName), "", "") {
+		offset += t.Size()
+		if err := stream.Send(&bigtablepb.SampleRowKeysResponse{RowKey: []byte(t.EndKey), OffsetBytes: offset}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MutateRow atomically applies mutations to one row.
+func (b *bigtableService) MutateRow(ctx context.Context, req *bigtablepb.MutateRowRequest) (*bigtablepb.MutateRowResponse, error) {
+	if len(req.Mutations) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no mutations")
+	}
+	rm, err := toTabletMutation(string(req.RowKey), req.Mutations)
+	if err != nil {
+		return nil, err
+	}
+
+	t := b.s.findTablet(tableID(req.TableName), rm.RowKey)
+	if t == nil {
+		return nil, status.Errorf(codes.NotFound, "no tablet found for key '%s'", rm.RowKey)
+	}
+	if err := t.Mutate(rm); err != nil {
+		return nil, grpcError(err)
+	}
+	return &bigtablepb.MutateRowResponse{}, nil
+}
+
+// MutateRows applies the entries as one batch and returns a status per entry.
+func (b *bigtableService) MutateRows(req *bigtablepb.MutateRowsRequest, stream bigtablepb.Bigtable_MutateRowsServer) error {
+	if len(req.Entries) == 0 {
+		return status.Error(codes.InvalidArgument, "no entries")
+	}
+
+	// 1. Convert the entries; those that cannot be converted fail alone
+	errs := make([]error, len(req.Entries))
+	var ms []*tablet.RowMutation
+	var idx []int
+	for i, e := range req.Entries {
+		if len(e.Mutations) == 0 {
+			errs[i] = status.Error(codes.InvalidArgument, "no mutations")
+			continue
+		}
+		rm, err := toTabletMutation(string(e.RowKey), e.Mutations)
+		if err != nil {
+			errs[i] = err
+			continue
+		}
+		ms = append(ms, rm)
+		idx = append(idx, i)
+	}
+
+	// 2. Apply the rest as one batch
+	for j, err := range b.s.MutateRows(tableID(req.TableName), ms, tablet.MutateOptions{Durability: tablet.DurabilitySync}) {
+		errs[idx[j]] = err
+	}
+
+	resp := &bigtablepb.MutateRowsResponse{Entries: make([]*bigtablepb.MutateRowsResponse_Entry, len(errs))}
+	for i, err := range errs {
+		resp.Entries[i] = &bigtablepb.MutateRowsResponse_Entry{
+			Index:  int64(i),
+			Status: status.Convert(grpcError(err)).Proto(),
+		}
+	}
+	return stream.Send(resp)
+}
+
+// CheckAndMutateRow applies the true or false mutations depending on the predicate.
+func (b *bigtableService) CheckAndMutateRow(ctx context.Context, req *bigtablepb.CheckAndMutateRowRequest) (*bigtablepb.CheckAndMutateRowResponse, error) {
+	if len(req.TrueMutations) == 0 && len(req.FalseMutations) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no mutations")
+	}
+	rowKey := string(req.RowKey)
+	predicate, err := toRowFilter(req.PredicateFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	// An empty branch writes nothing.
+	var onTrue, onFalse *tablet.RowMutation
+	if len(req.TrueMutations) > 0 {
+		if onTrue, err = toTabletMutation(rowKey, req.TrueMutations); err != nil {
+			return nil, err
+		}
+	}
+	if len(req.FalseMutations) > 0 {
+		if onFalse, err = toTabletMutation(rowKey, req.FalseMutations); err != nil {
+			return nil, err
+		}
+	}
+
+	t := b.s.findTablet(tableID(req.TableName), rowKey)
+	if t == nil {
+		return nil, status.Errorf(codes.NotFound, "no tablet found for key '%s'", rowKey)
+	}
+	matched, err := t.CheckAndMutate(rowKey, predicate, onTrue, onFalse)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &bigtablepb.CheckAndMutateRowResponse{PredicateMatched: matched}, nil
+}
+
+// ReadModifyWriteRow applies the rules and returns the cells they wrote.
+func (b *bigtableService) ReadModifyWriteRow(ctx context.Context, req *bigtablepb.ReadModifyWriteRowRequest) (*bigtablepb.ReadModifyWriteRowResponse, error) {
+	rmw := tablet.NewReadModifyWriteRow(string(req.RowKey))
+	for _, r := range req.Rules {
+		switch rule := r.Rule.(type) {
+		case *bigtablepb.ReadModifyWriteRule_AppendValue:
+			rmw.AddAppend(r.FamilyName, string(r.ColumnQualifier), rule.AppendValue)
+		case *bigtablepb.ReadModifyWriteRule_IncrementAmount:
+			rmw.AddIncrement(r.FamilyName, string(r.ColumnQualifier), rule.IncrementAmount)
+		default:
+			return nil, status.Error(codes.InvalidArgument, "rule without append_value or increment_amount")
+		}
+	}
+
+	t := b.s.findTablet(tableID(req.TableName), rmw.RowKey)
+	if t == nil {
+		return nil, status.Errorf(codes.NotFound, "no tablet found for key '%s'", rmw.RowKey)
+	}
+	row, err := t.ReadModifyWrite(rmw)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &bigtablepb.ReadModifyWriteRowResponse{Row: toPBRow(row)}, nil
+}
+
+// toPBRow converts a tablet row to a Bigtable row, families and columns sorted.
+func toPBRow(row *tablet.Row) *bigtablepb.Row {
+	out := &bigtablepb.Row{Key: []byte(row.Key)}
+	families := make(map[string]*bigtablepb.Family)
+	for _, col := range row.Columns {
+		fam, ok := families[col.Family]
+		if !ok {
+			fam = &bigtablepb.Family{Name: col.Family}
+			families[col.Family] = fam
+			out.Families = append(out.Families, fam)
+		}
+		pbCol := &bigtablepb.Column{Qualifier: []byte(col.Qualifier)}
+		for _, v := range col.Versions {
+			pbCol.Cells = append(pbCol.Cells, &bigtablepb.Cell{TimestampMicros: v.Timestamp / 1000, Value: v.Value})
+		}
+		fam.Columns = append(fam.Columns, pbCol)
+	}
+
+	sort.Slice(out.Families, func(i, j int) bool { return out.Families[i].Name < out.Families[j].Name })
+	for _, fam := range out.Families {
+		sort.Slice(fam.Columns, func(i, j int) bool {
+			return string(fam.Columns[i].Qualifier) < string(fam.Columns[j].Qualifier)
+		})
+	}
+	return out
+}
+
+// toTabletMutation converts Bigtable mutations of a row to a tablet mutation.
+// Bigtable timestamps are in microseconds, tablet timestamps in nanoseconds.
+// Timestamps assigned by the tablet are whole microseconds and convert exactly;
+// a time range covers every nanosecond of the microseconds in it.
+// A set_cell timestamp of -1 (or 0) lets the tablet assign the current time.
+func toTabletMutation(rowKey string, mutations []*bigtablepb.Mutation) (*tablet.RowMutation, error) {
+	rm := tablet.NewRowMutation(rowKey)
+	for _, m := range mutations {
+		switch m := m.Mutation.(type) {
+		case *bigtablepb.Mutation_SetCell_:
+			c := m.SetCell
+			var ts int64
+			if c.TimestampMicros > 0 {
+				ts = c.TimestampMicros * 1000
+			} else if c.TimestampMicros < -1 {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid timestamp %d", c.TimestampMicros)
+			}
+			rm.AddSet(c.FamilyName, string(c.ColumnQualifier), ts, c.Value)
+		case *bigtablepb.Mutation_DeleteFromColumn_:
+			c := m.DeleteFromColumn
+			if tr := c.TimeRange; tr != nil && (tr.StartTimestampMicros != 0 || tr.EndTimestampMicros != 0) {
+				rm.AddDeleteTimeRange(c.FamilyName, string(c.ColumnQualifier), tr.StartTimestampMicros*1000, tr.EndTimestampMicros*1000)
+			} else {
+				rm.AddDelete(c.FamilyName, string(c.ColumnQualifier))
+			}
+		case *bigtablepb.Mutation_DeleteFromFamily_:
+			rm.AddDeleteFamily(m.DeleteFromFamily.FamilyName)
+		case *bigtablepb.Mutation_DeleteFromRow_:
+			rm.AddDeleteRow()
+		default:
+			return nil, status.Error(codes.Unimplemented, "unsupported mutation")
+		}
+	}
+	return rm, nil
+}
+
+// toRowFilter converts a Bigtable filter to a tablet filter. A nil filter stays nil.
+// Filters with no tablet counterpart (row key regex, sink, labels) are rejected.
+func toRowFilter(f *bigtablepb.RowFilter) (*tablet.RowFilter, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	out := &tablet.RowFilter{}
+	var err error
+	switch f := f.Filter.(type) {
+	case *bigtablepb.RowFilter_Chain_:
+		out.Chain, err = toRowFilters(f.Chain.GetFilters())
+		if out.Chain == nil && err == nil {
+			out.Chain = []*tablet.RowFilter{}
+		}
+	case *bigtablepb.RowFilter_Interleave_:
+		out.Interleave, err = toRowFilters(f.Interleave.GetFilters())
+		if out.Interleave == nil && err == nil {
+			out.Interleave = []*tablet.RowFilter{}
+		}
+	case *bigtablepb.RowFilter_Condition_:
+		c := &tablet.FilterCondition{}
+		if c.Predicate, err = toRowFilter(f.Condition.GetPredicateFilter()); err != nil {
+			return nil, err
+		}
+		if c.True, err = toRowFilter(f.Condition.GetTrueFilter()); err != nil {
+			return nil, err
+		}
+		if c.False, err = toRowFilter(f.Condition.GetFalseFilter()); err != nil {
+			return nil, err
+		}
+		if c.Predicate == nil {
+			c.Predicate = &tablet.RowFilter{}
+		}
+		out.Condition = c
+	case *bigtablepb.RowFilter_PassAllFilter:
+	case *bigtablepb.RowFilter_BlockAllFilter:
+		out.BlockAll = f.BlockAllFilter
+	case *bigtablepb.RowFilter_FamilyNameRegexFilter:
+		out.FamilyRegex = regexFilter(f.FamilyNameRegexFilter)
+	case *bigtablepb.RowFilter_ColumnQualifierRegexFilter:
+		out.QualifierRegex = regexFilter(string(f.ColumnQualifierRegexFilter))
+	case *bigtablepb.RowFilter_ValueRegexFilter:
+		out.ValueRegex = regexFilter(string(f.ValueRegexFilter))
+	case *bigtablepb.RowFilter_ColumnRangeFilter:
+		r := f.ColumnRangeFilter
+		cr := &tablet.ColumnRange{Family: r.GetFamilyName()}
+		switch q := r.GetStartQualifier().(type) {
+		case *bigtablepb.ColumnRange_StartQualifierClosed:
+			cr.Start = string(q.StartQualifierClosed)
+		case *bigtablepb.ColumnRange_StartQualifierOpen:
+			cr.Start = string(q.StartQualifierOpen) + "\x00"
+		}
+		switch q := r.GetEndQualifier().(type) {
+		case *bigtablepb.ColumnRange_EndQualifierOpen:
+			if len(q.EndQualifierOpen) == 0 {
+				out.BlockAll = true // Nothing sorts before the empty qualifier.
+				return out, nil
+			}
+			cr.End = string(q.EndQualifierOpen)
+		case *bigtablepb.ColumnRange_EndQualifierClosed:
+			cr.End = string(q.EndQualifierClosed) + "\x00"
+		}
+		out.QualifierRange = cr
+	case *bigtablepb.RowFilter_ValueRangeFilter:
+		r := f.ValueRangeFilter
+		vr := &tablet.ValueRange{}
+		switch v := r.GetStartValue().(type) {
+		case *bigtablepb.ValueRange_StartValueClosed:
+			vr.Start = v.StartValueClosed
+		case *bigtablepb.ValueRange_StartValueOpen:
+			vr.Start = append(append([]byte{}, v.StartValueOpen...), 0)
+		}
+		switch v := r.GetEndValue().(type) {
+		case *bigtablepb.ValueRange_EndValueOpen:
+			vr.End = v.EndValueOpen
+		case *bigtablepb.ValueRange_EndValueClosed:
+			vr.End = append(append([]byte{}, v.EndValueClosed...), 0)
+		}
+		out.ValueRange = vr
+	case *bigtablepb.RowFilter_TimestampRangeFilter:
+		out.TimestampRange = &tablet.TimestampRange{
+			Start: f.TimestampRangeFilter.GetStartTimestampMicros() * 1000,
+			End:   f.TimestampRangeFilter.GetEndTimestampMicros() * 1000,
+		}
+	case *bigtablepb.RowFilter_CellsPerColumnLimitFilter:
+		out.CellsPerColumnLimit = int(f.CellsPerColumnLimitFilter)
+	case *bigtablepb.RowFilter_CellsPerRowOffsetFilter:
+		out.CellsPerRowOffset = int(f.CellsPerRowOffsetFilter)
+	case *bigtablepb.RowFilter_CellsPerRowLimitFilter:
+		out.CellsPerRowLimit = int(f.CellsPerRowLimitFilter)
+	case *bigtablepb.RowFilter_RowSampleFilter:
+		out.RowSampleProbability = f.RowSampleFilter
+	case *bigtablepb.RowFilter_StripValueTransformer:
+		out.StripValue = f.StripValueTransformer
+	case *bigtablepb.RowFilter_RowKeyRegexFilter:
+		return nil, status.Error(codes.Unimplemented, "row key regex filters are not supported")
+	case *bigtablepb.RowFilter_Sink:
+		if f.Sink {
+			return nil, status.Error(codes.Unimplemented, "sink filters are not supported")
+		}
+	case *bigtablepb.RowFilter_ApplyLabelTransformer:
+		return nil, status.Error(codes.Unimplemented, "label transformers are not supported")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func toRowFilters(filters []*bigtablepb.RowFilter) ([]*tablet.RowFilter, error) {
+	var out []*tablet.RowFilter
+	for _, f := range filters {
+		tf, err := toRowFilter(f)
+		if err != nil {
+			return nil, err
+		}
+		if tf == nil {
+			tf = &tablet.RowFilter{}
+		}
+		out = append(out, tf)
+	}
+	return out, nil
+}
+
+// regexFilter keeps an empty expression meaningful: tablet filters treat an
+// empty regex as unset, while Bigtable matches only the empty string with it.
+func regexFilter(expr string) string {
+	if expr == "" {
+		return "(?:)"
+	}
+	return expr
+}
+
+// grpcError maps a tablet error to a gRPC status error. Errors that already
+// carry a status, and nil, are returned as is.
+func grpcError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	switch {
+	case errors.Is(err, tablet.ErrUnknownColumnFamily), errors.Is(err, tablet.ErrInvalidMutation):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, tablet.ErrTabletClosed):
+		return status.Error(codes.Unavailable, err.Error())
+	case errors.Is(err, tablet.ErrKeyOutOfRange):
+		return status.Error(codes.NotFound, err.Error())
+	default:
+		return status.Error(codes.Internal, fmt.Sprint(err))
+	}
+}
@@ -0,0 +1,493 @@
+package tabletserver
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/Gourab-18/google_big_table/pkg/bigtablepb"
+	"github.com/Gourab-18/google_big_table/pkg/tablet"
+)
+
+const testTableName = "projects/p/instances/i/tables/default"
+
+// dialTestGRPC serves the data API of s in memory and returns a client of it.
+// The connection uses the default codec, like any Bigtable client.
+func dialTestGRPC(t *testing.T, s *TabletServer) bigtablepb.BigtableClient {
+	t.Helper()
+	lis := bufconn.Listen(1 << 20)
+	srv := s.NewGRPCServer()
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return bigtablepb.NewBigtableClient(conn)
+}
+
+// readRows runs ReadRows and merges the chunks the way Bigtable client
+// libraries do, failing on any chunk they would reject. It returns the
+// committed rows as "family:qualifier@micros=value" cells, in order, and the
+// number of responses they came in.
+func readRows(t *testing.T, client bigtablepb.BigtableClient, req *bigtablepb.ReadRowsRequest) ([]string, map[string][]string, int, error) {
+	t.Helper()
+	stream, err := client.ReadRows(context.Background(), req)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	var keys []string
+	rows := make(map[string][]string)
+	var key, lastKey, family, qualifier string
+	var cells []string
+	var value []byte
+	var micros int64
+	inRow, inValue := false, false
+	responses := 0
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return keys, rows, responses, err
+		}
+		responses++
+		for _, c := range resp.Chunks {
+			if c.GetResetRow() {
+				t.Fatal("unexpected reset_row")
+			}
+			if !inRow {
+				if len(c.RowKey) == 0 || c.FamilyName == nil || c.Qualifier == nil {
+					t.Fatalf("row starts with chunk %v, want row key, family and qualifier", c)
+				}
+				if lastKey != "" && string(c.RowKey) <= lastKey {
+					t.Fatalf("row %q after %q", c.RowKey, lastKey)
+				}
+				key, inRow = string(c.RowKey), true
+			} else if len(c.RowKey) > 0 && string(c.RowKey) != key {
+				t.Fatalf("row key %q in the middle of row %q", c.RowKey, key)
+			}
+			if inValue && (c.FamilyName != nil || c.Qualifier != nil || c.TimestampMicros != 0) {
+				t.Fatalf("cell starts in the middle of a split value: %v", c)
+			}
+			if c.FamilyName != nil {
+				if c.Qualifier == nil {
+					t.Fatalf("new family without a qualifier: %v", c)
+				}
+				family = c.FamilyName.Value
+			}
+			if c.Qualifier != nil {
+				qualifier = string(c.Qualifier.Value)
+			}
+			if !inValue {
+				micros = c.TimestampMicros
+			}
+			value = append(value, c.Value...)
+			if c.ValueSize > 0 {
+				inValue = true
+				continue // More of the value follows.
+			}
+			if inValue && len(value) == 0 {
+				t.Fatal("split value ends with nothing")
+			}
+			inValue = false
+			cells = append(cells, fmt.Sprintf("%s:%s@%d=%s", family, qualifier, micros, value))
+			value = nil
+			if c.GetCommitRow() {
+				keys = append(keys, key)
+				rows[key], cells, lastKey, inRow = cells, nil, key, false
+			}
+		}
+	}
+	if inRow {
+		t.Errorf("row %q was never committed", key)
+	}
+	return keys, rows, responses, nil
+}
+
+func setCell(family, qualifier string, micros int64, value string) *bigtablepb.Mutation {
+	return &bigtablepb.Mutation{Mutation: &bigtablepb.Mutation_SetCell_{SetCell: &bigtablepb.Mutation_SetCell{
+		FamilyName: family, ColumnQualifier: []byte(qualifier), TimestampMicros: micros, Value: []byte(value),
+	}}}
+}
+
+func rowRange(start, end string) *bigtablepb.RowRange {
+	return &bigtablepb.RowRange{
+		StartKey: &bigtablepb.RowRange_StartKeyClosed{StartKeyClosed: []byte(start)},
+		EndKey:   &bigtablepb.RowRange_EndKeyOpen{EndKeyOpen: []byte(end)},
+	}
+}
+
+func TestReadRowsRejectsUnservedRanges(t *testing.T) {
+	s := newTestServerWithTablets(t, [2]string{"", "m"})
+	for _, key := range []string{"a", "b"} {
+		m := tablet.NewRowMutation(key)
+		m.AddSet("cf", "q", 1000, []byte("v"))
+		if err := s.Tablets[0].Mutate(m); err != nil {
+			t.Fatal(err)
+		}
+	}
+	client := dialTestGRPC(t, s)
+	pastTablet := &bigtablepb.RowRange{
+		StartKey: &bigtablepb.RowRange_StartKeyClosed{StartKeyClosed: []byte("a")},
+		EndKey:   &bigtablepb.RowRange_EndKeyClosed{EndKeyClosed: []byte("m")},
+	}
+
+	tests := []struct {
+		name string
+		rows *bigtablepb.RowSet
+		want codes.Code
+	}{
+		{"served range", &bigtablepb.RowSet{RowRanges: []*bigtablepb.RowRange{rowRange("a", "m")}}, codes.OK},
+		{"served key", &bigtablepb.RowSet{RowKeys: [][]byte{[]byte("b")}}, codes.OK},
+		{"whole table", nil, codes.NotFound},
+		{"range past the tablet", &bigtablepb.RowSet{RowRanges: []*bigtablepb.RowRange{pastTablet}}, codes.NotFound},
+		{"unserved key", &bigtablepb.RowSet{RowKeys: [][]byte{[]byte("a"), []byte("x")}}, codes.NotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keys, _, _, err := readRows(t, client, &bigtablepb.ReadRowsRequest{Rows: tt.rows})
+			if got := status.Code(err); got != tt.want {
+				t.Fatalf("ReadRows = %v, want %v", err, tt.want)
+			}
+			if tt.want == codes.OK && len(keys) == 0 {
+				t.Error("ReadRows returned no rows")
+			}
+			if tt.want != codes.OK && len(keys) > 0 {
+				t.Errorf("failed ReadRows sent rows %v first", keys)
+			}
+		})
+	}
+}
+
+func TestReadRowsChunking(t *testing.T) {
+	s := newTestServerWithTablets(t, [2]string{"", ""})
+	client := dialTestGRPC(t, s)
+	ctx := context.Background()
+	big := strings.Repeat("x", 2*readRowsResponseBytes+10)
+	mutations := map[string][]*bigtablepb.Mutation{
+		"a": {setCell("cf", "q", 1000, "1"), setCell("cf", "q", 2000, "2"), setCell("cf", "r", 1000, "r")},
+		"b": {setCell("cf", "big", 1000, big), setCell("cf", "small", 1000, "s")},
+		"c": {setCell("cf", "q", 1000, "c")},
+	}
+	for key, ms := range mutations {
+		if _, err := client.MutateRow(ctx, &bigtablepb.MutateRowRequest{TableName: testTableName, RowKey: []byte(key), Mutations: ms}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// A newer version, written separately.
+	if _, err := client.MutateRow(ctx, &bigtablepb.MutateRowRequest{TableName: testTableName, RowKey: []byte("a"), Mutations: []*bigtablepb.Mutation{setCell("cf", "q", 3000, "3")}}); err != nil {
+		t.Fatal(err)
+	}
+
+	keys, rows, responses, err := readRows(t, client, &bigtablepb.ReadRowsRequest{TableName: testTableName})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string][]string{
+		"a": {"cf:q@3000=3", "cf:q@2000=2", "cf:q@1000=1", "cf:r@1000=r"},
+		"b": {"cf:big@1000=" + big, "cf:small@1000=s"},
+		"c": {"cf:q@1000=c"},
+	}
+	if !reflect.DeepEqual(keys, []string{"a", "b", "c"}) || !reflect.DeepEqual(rows, want) {
+		t.Errorf("ReadRows = %v, want the written rows", keys)
+	}
+	if responses < 3 {
+		t.Errorf("ReadRows sent %d responses, want the large value split across several", responses)
+	}
+
+	// A limit stops after whole rows, even when a row spans responses.
+	keys, _, _, err = readRows(t, client, &bigtablepb.ReadRowsRequest{TableName: testTableName, RowsLimit: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(keys, []string{"a", "b"}) {
+		t.Errorf("ReadRows with a limit of 2 = %v, want a and b", keys)
+	}
+	if _, _, _, err := readRows(t, client, &bigtablepb.ReadRowsRequest{RowsLimit: -1}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("ReadRows with a negative limit = %v, want InvalidArgument", err)
+	}
+}
+
+func TestReadRowsFilters(t *testing.T) {
+	s := newTestServerWithTablets(t, [2]string{"", ""})
+	client := dialTestGRPC(t, s)
+	for key, ms := range map[string][]*bigtablepb.Mutation{
+		"a": {setCell("cf", "q", 1000, "1"), setCell("cf", "q", 2000, "one"), setCell("cf", "r", 1000, "x")},
+		"b": {setCell("cf", "q", 1000, "2")},
+		"c": {setCell("cf", "q", 1000, "3")},
+	} {
+		if _, err := client.MutateRow(context.Background(), &bigtablepb.MutateRowRequest{RowKey: []byte(key), Mutations: ms}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	chain := &bigtablepb.RowFilter{Filter: &bigtablepb.RowFilter_Chain_{Chain: &bigtablepb.RowFilter_Chain{Filters: []*bigtablepb.RowFilter{
+		{Filter: &bigtablepb.RowFilter_FamilyNameRegexFilter{FamilyNameRegexFilter: "cf"}},
+		{Filter: &bigtablepb.RowFilter_ColumnQualifierRegexFilter{ColumnQualifierRegexFilter: []byte("q")}},
+		{Filter: &bigtablepb.RowFilter_CellsPerColumnLimitFilter{CellsPerColumnLimitFilter: 1}},
+	}}}}
+	_, rows, _, err := readRows(t, client, &bigtablepb.ReadRowsRequest{
+		Rows:   &bigtablepb.RowSet{RowRanges: []*bigtablepb.RowRange{rowRange("a", "c")}},
+		Filter: chain,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := map[string][]string{"a": {"cf:q@2000=one"}, "b": {"cf:q@1000=2"}}; !reflect.DeepEqual(rows, want) {
+		t.Errorf("ReadRows with a chain = %v, want %v", rows, want)
+	}
+
+	unsupported := &bigtablepb.RowFilter{Filter: &bigtablepb.RowFilter_RowKeyRegexFilter{RowKeyRegexFilter: []byte("a")}}
+	if _, _, _, err := readRows(t, client, &bigtablepb.ReadRowsRequest{Filter: unsupported}); status.Code(err) != codes.Unimplemented {
+		t.Errorf("ReadRows with a row key regex = %v, want Unimplemented", err)
+	}
+
+	// Reversed scans are newer than the generated messages: the field arrives unknown.
+	reversed := &bigtablepb.ReadRowsRequest{}
+	reversed.ProtoReflect().SetUnknown(protowire.AppendVarint(protowire.AppendTag(nil, readRowsReversedField, protowire.VarintType), 1))
+	if _, _, _, err := readRows(t, client, reversed); status.Code(err) != codes.Unimplemented {
+		t.Errorf("reversed ReadRows = %v, want Unimplemented", err)
+	}
+}
+
+func TestMutateRowsReturnsStatusPerEntry(t *testing.T) {
+	s := newTestServerWithTablets(t, [2]string{"", ""})
+	client := dialTestGRPC(t, s)
+	stream, err := client.MutateRows(context.Background(), &bigtablepb.MutateRowsRequest{TableName: testTableName, Entries: []*bigtablepb.MutateRowsRequest_Entry{
+		{RowKey: []byte("a"), Mutations: []*bigtablepb.Mutation{setCell("cf", "q", 1000, "1")}},
+		{RowKey: []byte("b"), Mutations: []*bigtablepb.Mutation{setCell("nope", "q", 1000, "2")}},
+		{RowKey: []byte("c")},
+		{RowKey: []byte("d"), Mutations: []*bigtablepb.Mutation{setCell("cf", "q", 1000, "4")}},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	codesByIndex := make(map[int64]codes.Code)
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, e := range resp.Entries {
+			codesByIndex[e.Index] = codes.Code(e.Status.GetCode())
+		}
+	}
+	want := map[int64]codes.Code{0: codes.OK, 1: codes.InvalidArgument, 2: codes.InvalidArgument, 3: codes.OK}
+	if !reflect.DeepEqual(codesByIndex, want) {
+		t.Errorf("MutateRows statuses = %v, want %v", codesByIndex, want)
+	}
+	_, rows, _, err := readRows(t, client, &bigtablepb.ReadRowsRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := map[string][]string{"a": {"cf:q@1000=1"}, "d": {"cf:q@1000=4"}}; !reflect.DeepEqual(rows, want) {
+		t.Errorf("rows after MutateRows = %v, want %v", rows, want)
+	}
+}
+
+func TestCheckAndMutateRow(t *testing.T) {
+	s := newTestServerWithTablets(t, [2]string{"", ""})
+	client := dialTestGRPC(t, s)
+	ctx := context.Background()
+	if _, err := client.MutateRow(ctx, &bigtablepb.MutateRowRequest{RowKey: []byte("b"), Mutations: []*bigtablepb.Mutation{setCell("cf", "q", 1000, "2")}}); err != nil {
+		t.Fatal(err)
+	}
+
+	checkAndMutate := func(pattern string) bool {
+		t.Helper()
+		resp, err := client.CheckAndMutateRow(ctx, &bigtablepb.CheckAndMutateRowRequest{
+			TableName:       testTableName,
+			RowKey:          []byte("b"),
+			PredicateFilter: &bigtablepb.RowFilter{Filter: &bigtablepb.RowFilter_ValueRegexFilter{ValueRegexFilter: []byte(pattern)}},
+			TrueMutations:   []*bigtablepb.Mutation{setCell("cf", "m", 3000, "true")},
+			FalseMutations:  []*bigtablepb.Mutation{setCell("cf", "m", 3000, "false")},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resp.PredicateMatched
+	}
+	if !checkAndMutate("2") {
+		t.Error("CheckAndMutateRow did not match value 2")
+	}
+	if checkAndMutate("nope") {
+		t.Error("CheckAndMutateRow matched a missing value")
+	}
+	onlyM := &bigtablepb.RowFilter{Filter: &bigtablepb.RowFilter_ColumnQualifierRegexFilter{ColumnQualifierRegexFilter: []byte("m")}}
+	_, rows, _, err := readRows(t, client, &bigtablepb.ReadRowsRequest{Filter: onlyM})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := map[string][]string{"b": {"cf:m@3000=false"}}; !reflect.DeepEqual(rows, want) {
+		t.Errorf("after CheckAndMutateRow rows = %v, want the false branch to have overwritten the true one", rows)
+	}
+
+	if _, err := client.CheckAndMutateRow(ctx, &bigtablepb.CheckAndMutateRowRequest{RowKey: []byte("b")}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("CheckAndMutateRow without mutations = %v, want InvalidArgument", err)
+	}
+}
+
+func TestReadModifyWriteRow(t *testing.T) {
+	s := newTestServerWithTablets(t, [2]string{"", ""})
+	client := dialTestGRPC(t, s)
+	increment := &bigtablepb.ReadModifyWriteRule{FamilyName: "cf", ColumnQualifier: []byte("n"), Rule: &bigtablepb.ReadModifyWriteRule_IncrementAmount{IncrementAmount: 5}}
+	appendAB := &bigtablepb.ReadModifyWriteRule{FamilyName: "cf", ColumnQualifier: []byte("s"), Rule: &bigtablepb.ReadModifyWriteRule_AppendValue{AppendValue: []byte("ab")}}
+	readModifyWrite := func(rules ...*bigtablepb.ReadModifyWriteRule) (*bigtablepb.Row, error) {
+		resp, err := client.ReadModifyWriteRow(context.Background(), &bigtablepb.ReadModifyWriteRowRequest{TableName: testTableName, RowKey: []byte("d"), Rules: rules})
+		return resp.GetRow(), err
+	}
+
+	if _, err := readModifyWrite(increment); err != nil {
+		t.Fatal(err)
+	}
+	row, err := readModifyWrite(increment, appendAB)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(row.Key) != "d" || len(row.Families) != 1 || row.Families[0].Name != "cf" {
+		t.Fatalf("ReadModifyWriteRow returned %v, want row d with family cf", row)
+	}
+	cols := row.Families[0].Columns
+	if len(cols) != 2 || string(cols[0].Qualifier) != "n" || string(cols[1].Qualifier) != "s" {
+		t.Fatalf("ReadModifyWriteRow returned columns %v, want n and s", cols)
+	}
+	if n := cols[0].Cells[0].Value; len(n) != 8 || binary.BigEndian.Uint64(n) != 10 {
+		t.Errorf("incremented cf:n = %q, want 10 as a 64-bit big-endian integer", n)
+	}
+	if v := cols[1].Cells[0].Value; !bytes.Equal(v, []byte("ab")) {
+		t.Errorf("appended cf:s = %q, want ab", v)
+	}
+
+	if _, err := readModifyWrite(&bigtablepb.ReadModifyWriteRule{FamilyName: "cf", ColumnQualifier: []byte("n")}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("ReadModifyWriteRow with an empty rule = %v, want InvalidArgument", err)
+	}
+	incrementS := &bigtablepb.ReadModifyWriteRule{FamilyName: "cf", ColumnQualifier: []byte("s"), Rule: &bigtablepb.ReadModifyWriteRule_IncrementAmount{IncrementAmount: 1}}
+	if _, err := readModifyWrite(incrementS); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("ReadModifyWriteRow incrementing a 2-byte value = %v, want InvalidArgument", err)
+	}
+	if _, err := readModifyWrite(); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("ReadModifyWriteRow without rules = %v, want InvalidArgument", err)
+	}
+}
+
+func TestSampleRowKeys(t *testing.T) {
+	s := newTestServerWithTablets(t, [2]string{"", "m"}, [2]string{"m", ""})
+	client := dialTestGRPC(t, s)
+	for _, key := range []string{"a", "b", "x"} {
+		if _, err := client.MutateRow(context.Background(), &bigtablepb.MutateRowRequest{RowKey: []byte(key), Mutations: []*bigtablepb.Mutation{setCell("cf", "q", 1000, "v")}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	stream, err := client.SampleRowKeys(context.Background(), &bigtablepb.SampleRowKeysRequest{TableName: testTableName})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var keys []string
+	var offsets []int64
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		keys = append(keys, string(resp.RowKey))
+		offsets = append(offsets, resp.OffsetBytes)
+	}
+	if !reflect.DeepEqual(keys, []string{"m", ""}) {
+		t.Fatalf("SampleRowKeys keys = %q, want the tablet end keys m and the end of the table", keys)
+	}
+	if offsets[0] <= 0 || offsets[1] <= offsets[0] {
+		t.Errorf("SampleRowKeys offsets = %v, want them to grow with the data before each key", offsets)
+	}
+}
+
+func TestCellWrittenOverHTTPIsAddressableOverGRPC(t *testing.T) {
+	s := newTestServerWithTablets(t, [2]string{"", ""})
+	client := dialTestGRPC(t, s)
+	ctx := context.Background()
+	rec := post(s.HandleMutate, "/mutate", `{"RowKey": "k", "Ops": [{"Type": 0, "Family": "cf", "Qualifier": "q", "Value": "djE="}]}`)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("HTTP mutate = %d (%s)", rec.Code, rec.Body)
+	}
+
+	stream, err := client.ReadRows(ctx, &bigtablepb.ReadRowsRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := stream.Recv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ts := resp.Chunks[0].TimestampMicros
+
+	// Overwriting at the timestamp read replaces the cell rather than adding a version.
+	_, err = client.MutateRow(ctx, &bigtablepb.MutateRowRequest{RowKey: []byte("k"), Mutations: []*bigtablepb.Mutation{setCell("cf", "q", ts, "v2")}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	versions, err := s.Tablets[0].ReadVersions("k", "cf", "q", tablet.ReadOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(versions) != 1 || string(versions[0].Value) != "v2" {
+		t.Fatalf("versions after overwriting = %v, want only v2", versions)
+	}
+
+	// A later cell survives deleting exactly the one that was read.
+	_, err = client.MutateRow(ctx, &bigtablepb.MutateRowRequest{RowKey: []byte("k"), Mutations: []*bigtablepb.Mutation{
+		setCell("cf", "q", ts+1, "v3"),
+		deleteFromColumn("cf", "q", ts, ts+1),
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	versions, err = s.Tablets[0].ReadVersions("k", "cf", "q", tablet.ReadOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(versions) != 1 || string(versions[0].Value) != "v3" {
+		t.Errorf("versions after deleting = %v, want only v3", versions)
+	}
+}
+
+func deleteFromColumn(family, qualifier string, start, end int64) *bigtablepb.Mutation {
+	return &bigtablepb.Mutation{Mutation: &bigtablepb.Mutation_DeleteFromColumn_{DeleteFromColumn: &bigtablepb.Mutation_DeleteFromColumn{
+		FamilyName: family, ColumnQualifier: []byte(qualifier),
+		TimeRange: &bigtablepb.TimestampRange{StartTimestampMicros: start, EndTimestampMicros: end},
+	}}}
+}
+
+func TestMutateRowRejectsInvertedTimeRange(t *testing.T) {
+	s := newTestServerWithTablets(t, [2]string{"", ""})
+	client := dialTestGRPC(t, s)
+	_, err := client.MutateRow(context.Background(), &bigtablepb.MutateRowRequest{RowKey: []byte("k"), Mutations: []*bigtablepb.Mutation{deleteFromColumn("cf", "q", 5, 3)}})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("MutateRow = %v, want InvalidArgument", err)
+	}
+}
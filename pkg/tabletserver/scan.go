@@ -0,0 +1,188 @@
+package tabletserver
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/Gourab-18/google_big_table/pkg/tablet"
+)
+
+// scanFlushEvery is how many rows are written between two flushes of a scan response.
+const scanFlushEvery = 100
+
+// scanLine is one line of a /scan response. Every line holds exactly one field.
+type scanLine struct {
+	Row *tablet.Row `json:",omitempty"`
+	// NextPageToken ends a page that stopped at the row limit. Passing it back
+	// as page_token, with the same range, continues the scan after the last row.
+	NextPageToken string `json:",omitempty"`
+	// Error ends a scan that failed after rows were already sent.
+	Error string `json:",omitempty"`
+}
+
+// HandleScan streams the rows of a key range as newline-delimited JSON scanLines.
+//
+// Query parameters: table (the default table if none); start and end (range [start, end); empty end means no bound),
+// or prefix instead of both; filter, a JSON-encoded tablet.RowFilter; limit, the
+// maximum number of rows; and page_token, from a previous page.
+//
+// Rows are written as they are read, so the response is never buffered as a whole.
+// Page tokens hold the last row key returned rather than a tablet, so they stay
+// valid across tablet splits. The scan stops when the client goes away.
+// A range that the server's tablets do not fully cover is rejected with StatusNotServed.
+func (s *TabletServer) HandleScan(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	start, end := q.Get("start"), q.Get("end")
+	if prefix := q.Get("prefix"); prefix != "" {
+		if start != "" || end != "" {
+			http.Error(w, "prefix cannot be combined with start or end", http.StatusBadRequest)
+			return
+		}
+		start, end = prefix, tablet.PrefixSuccessor(prefix)
+	}
+
+	var limit int
+	if v := q.Get("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 {
+			http.Error(w, fmt.Sprintf("invalid limit %q", v), http.StatusBadRequest)
+			return
+		}
+		limit = n
+	}
+
+	filter, err := parseRowFilter(q.Get("filter"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if token := q.Get("page_token"); token != "" {
+		lastKey, err := decodePageToken(token)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		// Resume right after the last row returned.
+		if next := lastKey + "\x00"; next > start {
+			start = next
+		}
+	}
+
+	// Refuse ranges this server only partly serves, rather than silently skipping
+	// rows held elsewhere, so that clients with stale locations can tell.
+	tablets := s.tabletsInRange(tableParam(r), start, end)
+	if key, ok := firstGap(tablets, start, end); ok {
+		notServed(w, key)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	enc := json.NewEncoder(w)
+	flusher, _ := w.(http.Flusher)
+	ctx := r.Context()
+
+	sent := 0
+	var lastKey string
+	for _, t := range tablets {
+		it, err := t.Scan(start, end, tablet.ScanOptions{Filter: filter})
+		if err != nil && sent == 0 {
+			// Nothing was written yet, e.g. the tablet was unloaded meanwhile.
+			http.Error(w, err.Error(), errorStatus(err))
+			return
+		}
+		if err != nil {
+			enc.Encode(scanLine{Error: err.Error()})
+			return
+		}
+
+		for it.Next() {
+			if ctx.Err() != nil {
+				// Client disconnected; nobody is reading any more.
+				it.Close()
+				return
+			}
+			if limit > 0 && sent == limit {
+				it.Close()
+				enc.Encode(scanLine{NextPageToken: encodePageToken(lastKey)})
+				return
+			}
+
+			row := it.Row()
+			if err := enc.Encode(scanLine{Row: row}); err != nil {
+				it.Close()
+				return
+			}
+			lastKey = row.Key
+			sent++
+			if flusher != nil && sent%scanFlushEvery == 0 {
+				flusher.Flush()
+			}
+		}
+		err = it.Err()
+		it.Close()
+		if err != nil {
+			enc.Encode(scanLine{Error: err.Error()})
+			return
+		}
+	}
+}
+
+// tabletsInRange returns the tablets of table overlapping [start, end), ordered by key.
+func (s *TabletServer) tabletsInRange(table, start, end string) []*tablet.Tablet {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var out []*tablet.Tablet
+	for _, t := range s.Tablets {
+		if s.tables[t.ID] != table {
+			continue
+		}
+		if t.EndKey != "" && t.EndKey <= start {
+			continue
+		}
+		if end != "" && t.StartKey >= end {
+			continue
+		}
+		out = append(out, t)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].StartKey < out[j].StartKey })
+	return out
+}
+
+// firstGap returns the first key of [start, end) that none of the tablets,
+// ordered by key, covers.
+func firstGap(tablets []*tablet.Tablet, start, end string) (string, bool) {
+	pos := start
+	for _, t := range tablets {
+		if t.StartKey > pos {
+			return pos, true
+		}
+		if t.EndKey == "" {
+			return "", false
+		}
+		if t.EndKey > pos {
+			pos = t.EndKey
+		}
+	}
+	if end == "" || pos < end {
+		return pos, true
+	}
+	return "", false
+}
+
+func encodePageToken(lastKey string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(lastKey))
+}
+
+func decodePageToken(token string) (string, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return "", fmt.Errorf("invalid page_token: %v", err)
+	}
+	return string(b), nil
+}
@@ -0,0 +1,229 @@
+package tabletserver
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/Gourab-18/google_big_table/pkg/tablet"
+)
+
+// writeScanTestRows writes the row keys k00 to k<n-1> to cf:q of their rows.
+func writeScanTestRows(t *testing.T, s *TabletServer, n int) []string {
+	t.Helper()
+	var keys []string
+	for i := 0; i < n; i++ {
+		key := fmt.Sprintf("k%02d", i)
+		body := fmt.Sprintf(`{"RowKey": %q, "Ops": [{"Type": 0, "Family": "cf", "Qualifier": "q", "Value": %q}]}`, key, base64.StdEncoding.EncodeToString([]byte(key)))
+		if rec := post(s.HandleMutate, "/mutate", body); rec.Code != http.StatusOK {
+			t.Fatalf("mutate %s = %d (%s)", key, rec.Code, rec.Body)
+		}
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// scanPage runs HandleScan and returns the status, the row keys and the page
+// token of the response. It fails the test on badly framed responses.
+func scanPage(t *testing.T, s *TabletServer, query url.Values) (int, []string, string) {
+	t.Helper()
+	rec := get(s.HandleScan, "/scan?"+query.Encode())
+	if rec.Code != http.StatusOK {
+		return rec.Code, nil, ""
+	}
+	if ct := rec.Header().Get("Content-Type"); ct != "application/x-ndjson" {
+		t.Errorf("Content-Type = %q, want application/x-ndjson", ct)
+	}
+	body := rec.Body.Bytes()
+	if len(body) > 0 && body[len(body)-1] != '\n' {
+		t.Errorf("response does not end with a newline: %q", body)
+	}
+	var keys []string
+	var token string
+	for _, line := range bytes.Split(bytes.TrimSuffix(body, []byte("\n")), []byte("\n")) {
+		if len(line) == 0 {
+			continue
+		}
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(line, &fields); err != nil {
+			t.Fatalf("line %q is not a JSON object: %v", line, err)
+		}
+		if len(fields) != 1 {
+			t.Errorf("line %q has %d fields, want one", line, len(fields))
+		}
+		if token != "" {
+			t.Errorf("line %q follows the page token", line)
+		}
+		var sl scanLine
+		if err := json.Unmarshal(line, &sl); err != nil {
+			t.Fatal(err)
+		}
+		switch {
+		case sl.Row != nil:
+			keys = append(keys, sl.Row.Key)
+		case sl.NextPageToken != "":
+			token = sl.NextPageToken
+		default:
+			t.Fatalf("scan failed: %s", line)
+		}
+	}
+	return rec.Code, keys, token
+}
+
+// scanAll follows page tokens until the scan ends and returns every key and
+// the number of pages.
+func scanAll(t *testing.T, s *TabletServer, query url.Values, between func()) ([]string, int) {
+	t.Helper()
+	var all []string
+	pages := 0
+	for {
+		code, keys, token := scanPage(t, s, query)
+		if code != http.StatusOK {
+			t.Fatalf("scan of page %d = %d", pages+1, code)
+		}
+		all = append(all, keys...)
+		pages++
+		if token == "" {
+			return all, pages
+		}
+		if between != nil {
+			between()
+		}
+		query.Set("page_token", token)
+	}
+}
+
+func TestScanLimitsAndPages(t *testing.T) {
+	s := newTestServer(t)
+	keys := writeScanTestRows(t, s, 20)
+
+	tests := []struct {
+		name      string
+		query     url.Values
+		wantKeys  []string
+		wantPages int
+	}{
+		{"no limit", url.Values{}, keys, 1},
+		{"limit", url.Values{"limit": {"7"}}, keys, 3},
+		{"limit of every row", url.Values{"limit": {"20"}}, keys, 1},
+		{"limit with a range", url.Values{"start": {"k05"}, "end": {"k15"}, "limit": {"4"}}, keys[5:15], 3},
+		{"limit with a prefix", url.Values{"prefix": {"k1"}, "limit": {"3"}}, keys[10:], 4},
+		{"rows left empty by the filter do not count", url.Values{
+			"filter": {`{"ValueRegex": "k.[02468]"}`},
+			"limit":  {"4"},
+		}, []string{"k00", "k02", "k04", "k06", "k08", "k10", "k12", "k14", "k16", "k18"}, 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, pages := scanAll(t, s, tt.query, nil)
+			if !reflect.DeepEqual(got, tt.wantKeys) {
+				t.Errorf("scanned %v, want %v", got, tt.wantKeys)
+			}
+			if pages != tt.wantPages {
+				t.Errorf("scan took %d pages, want %d", pages, tt.wantPages)
+			}
+		})
+	}
+
+	code, page, token := scanPage(t, s, url.Values{"limit": {"5"}})
+	if code != http.StatusOK || len(page) != 5 || token == "" {
+		t.Fatalf("first page = %d %v %q, want 5 rows and a token", code, page, token)
+	}
+	if _, page, _ := scanPage(t, s, url.Values{"limit": {"5"}, "page_token": {token}}); len(page) != 5 || page[0] != "k05" {
+		t.Errorf("second page = %v, want 5 rows from k05", page)
+	}
+}
+
+func TestScanRejectsBadRequests(t *testing.T) {
+	s := newTestServer(t)
+	for _, query := range []url.Values{
+		{"limit": {"-1"}},
+		{"limit": {"many"}},
+		{"page_token": {"not base64!"}},
+		{"filter": {`{"FamilyRegex": "("}`}},
+		{"prefix": {"k"}, "start": {"a"}},
+	} {
+		if code, _, _ := scanPage(t, s, query); code != http.StatusBadRequest {
+			t.Errorf("scan with %v = %d, want 400", query, code)
+		}
+	}
+
+	s = newTestServerWithTablets(t, [2]string{"", "m"})
+	if code, _, _ := scanPage(t, s, url.Values{}); code != StatusNotServed {
+		t.Errorf("scan past the served range = %d, want %d", code, StatusNotServed)
+	}
+	if code, _, _ := scanPage(t, s, url.Values{"end": {"m"}}); code != http.StatusOK {
+		t.Errorf("scan of the served range = %d, want 200", code)
+	}
+}
+
+// splitTestTablet splits the only tablet of s in two and serves the halves instead.
+func splitTestTablet(t *testing.T, s *TabletServer) {
+	t.Helper()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	parent := s.Tablets[0]
+	left, right, err := parent.Split(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.Tablets = []*tablet.Tablet{left, right}
+	s.tables[left.ID], s.tables[right.ID] = s.tables[parent.ID], s.tables[parent.ID]
+	if err := parent.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestScanPageTokenSurvivesSplit(t *testing.T) {
+	s := newTestServer(t)
+	keys := writeScanTestRows(t, s, 20)
+
+	split := false
+	got, pages := scanAll(t, s, url.Values{"limit": {"6"}}, func() {
+		if !split {
+			splitTestTablet(t, s)
+			split = true
+		}
+	})
+	if !reflect.DeepEqual(got, keys) {
+		t.Errorf("scan across a split returned %v, want every row once: %v", got, keys)
+	}
+	if pages != 4 {
+		t.Errorf("scan took %d pages, want 4", pages)
+	}
+	if len(s.Tablets) != 2 || s.Tablets[0].EndKey <= "k06" || s.Tablets[0].EndKey >= "k19" {
+		t.Errorf("split at %q, want the split inside the pages still to come", s.Tablets[0].EndKey)
+	}
+}
+
+// cancellingWriter cancels the request once the first row has been written.
+type cancellingWriter struct {
+	*httptest.ResponseRecorder
+	cancel context.CancelFunc
+}
+
+func (w *cancellingWriter) Write(p []byte) (int, error) {
+	w.cancel()
+	return w.ResponseRecorder.Write(p)
+}
+
+func TestScanStopsWhenClientGoesAway(t *testing.T) {
+	s := newTestServer(t)
+	writeScanTestRows(t, s, 20)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	w := &cancellingWriter{ResponseRecorder: httptest.NewRecorder(), cancel: cancel}
+	s.HandleScan(w, httptest.NewRequest(http.MethodGet, "/scan", nil).WithContext(ctx))
+
+	if lines := bytes.Count(w.Body.Bytes(), []byte("\n")); lines != 1 {
+		t.Errorf("scan wrote %d lines after the client went away, want only the first", lines)
+	}
+}
@@ -2,12 +2,16 @@ package tabletserver
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
+	"strconv"
 	"sync"
 
+	"github.com/Gourab-18/google_big_table/pkg/master"
 	"github.com/Gourab-18/google_big_table/pkg/tablet"
 )
 
@@ -16,10 +20,29 @@ type TabletServer struct {
 	mu      sync.RWMutex
 	RootDir string
 	Tablets []*tablet.Tablet // Keeping it simple: linear scan for range.
+	Options Options
+
+	tables      map[string]string // Tablet ID to the name of its table
+	masterEpoch string            // Epoch of the master that last answered a heartbeat
+
+	loadMu        sync.Mutex // Serializes loading and unloading tablets
+	heartbeatStop chan struct{}
+	heartbeatWG   sync.WaitGroup
 }
 
-// NewTabletServer creates a new TabletServer.
+// NewTabletServer creates a new standalone TabletServer, which serves every
+// tablet found under rootDir as the default table.
 func NewTabletServer(rootDir string) (*TabletServer, error) {
+	return NewTabletServerWithOptions(rootDir, DefaultOptions())
+}
+
+// NewTabletServerWithOptions creates a new TabletServer.
+//
+// With a master or a lock service configured, the server opens no tablet by itself: it registers
+// with the master and serves what the master assigns it, loading tablets from
+// rootDir, which all servers share. Otherwise it serves every tablet found
+// under rootDir, bootstrapping a root tablet if there is none.
+func NewTabletServerWithOptions(rootDir string, opts Options) (*TabletServer, error) {
 	if err := os.MkdirAll(rootDir, 0755); err != nil {
 		return nil, err
 	}
@@ -27,6 +50,15 @@ func NewTabletServer(rootDir string) (*TabletServer, error) {
 	ts := &TabletServer{
 		RootDir: rootDir,
 		Tablets: make([]*tablet.Tablet, 0),
+		Options: opts,
+		tables:  make(map[string]string),
+	}
+
+	if ts.Options.MasterAddr != "" || ts.Options.Lock != nil {
+		ts.heartbeatStop = make(chan struct{})
+		ts.heartbeatWG.Add(1)
+		go ts.heartbeatLoop()
+		return ts, nil
 	}
 
 	// Bootstrap: Load existing tablets from subdirectories
@@ -36,27 +68,31 @@ func NewTabletServer(rootDir string) (*TabletServer, error) {
 	}
 
 	for _, entry := range entries {
-		if entry.IsDir() {
-			// Assume dir name is like "start_end" or just generic IDs.
-			// For this prototype, we'll try to guess ranges or just load them and inspect.
-			// Let's assume we store metadata or just re-open.
-			// Currently implementation of NewTablet takes start/end as args,
-			// so we strictly need to know them *before* opening,
-			// OR we store them in a metadata file inside the tablet dir.
-			// Let's cheat: we won't implement full specialized recovery of ranges yet.
-			// We will assume 1 default tablet if empty, or just manual setup for now.
+		if !entry.IsDir() {
+			continue
 		}
+		// Every tablet directory carries a manifest with its key range.
+		dir := filepath.Join(rootDir, entry.Name())
+		t, err := tablet.OpenTablet(dir, ts.Options.Tablet)
+		if err != nil {
+			fmt.Printf("Skipping %s: %v\n", dir, err)
+			continue
+		}
+		fmt.Printf("Loaded tablet %s [%s, %s)\n", t.ID, t.StartKey, t.EndKey)
+		ts.Tablets = append(ts.Tablets, t)
+		ts.tables[t.ID] = master.DefaultTable
 	}
 
 	// Auto-bootstrap root tablet if no tablets exist
 	if len(ts.Tablets) == 0 {
 		// Create default root tablet ["", "")
 		rootPath := filepath.Join(rootDir, "root_tablet")
-		root, err := tablet.NewTablet("", "", rootPath)
+		root, err := tablet.NewTabletWithOptions("", "", rootPath, ts.Options.Tablet)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create root tablet: %w", err)
 		}
 		ts.Tablets = append(ts.Tablets, root)
+		ts.tables[root.ID] = master.DefaultTable
 	}
 
 	return ts, nil
@@ -65,7 +101,16 @@ func NewTabletServer(rootDir string) (*TabletServer, error) {
 // Serve starts the HTTP server.
 func (s *TabletServer) Serve(addr string) error {
 	http.HandleFunc("/mutate", s.HandleMutate)
+	http.HandleFunc("/mutate-rows", s.HandleMutateRows)
 	http.HandleFunc("/read", s.HandleRead)
+	http.HandleFunc("/versions", s.HandleReadVersions)
+	http.HandleFunc("/row", s.HandleReadRow)
+	http.HandleFunc("/scan", s.HandleScan)
+	http.HandleFunc("/families", s.HandleColumnFamily)
+	http.HandleFunc("/check-and-mutate", s.HandleCheckAndMutate)
+	http.HandleFunc("/read-modify-write", s.HandleReadModifyWrite)
+	http.HandleFunc("/load", s.HandleLoad)
+	http.HandleFunc("/unload", s.HandleUnload)
 	return http.ListenAndServe(addr, nil)
 }
 
@@ -77,13 +122,9 @@ func (s *TabletServer) HandleMutate(w http.ResponseWriter, r *http.Request) {
 
 	var mut struct {
 		RowKey string
-		Ops    []struct {
-			Type      int
-			Family    string
-			Qualifier string
-			Timestamp int64
-			Value     []byte
-		}
+		Ops    []mutationOp
+		// Durability uses the tablet.Durability values; it defaults to sync.
+		Durability int
 	}
 
 	if err := json.NewDecoder(r.Body).Decode(&mut); err != nil {
@@ -92,24 +133,324 @@ func (s *TabletServer) HandleMutate(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Convert to internal Mutation
-	rm := tablet.NewRowMutation(mut.RowKey)
-	for _, op := range mut.Ops {
-		if op.Type == 0 { // Set, assuming enum matches
-			rm.AddSet(op.Family, op.Qualifier, op.Timestamp, op.Value)
+	rm, err := toRowMutation(mut.RowKey, mut.Ops)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	durability, err := toDurability(mut.Durability)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Find Tablet
+	t := s.findTablet(tableParam(r), rm.RowKey)
+	if t == nil {
+		notServed(w, rm.RowKey)
+		return
+	}
+
+	if err := t.MutateWithOptions(rm, tablet.MutateOptions{Durability: durability}); err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// MutateRows applies a batch of row mutations to a table, possibly spanning
+// several tablets, and returns one error per mutation, nil for those that succeeded.
+// Mutations are grouped by tablet, and each tablet logs its group as one batch.
+func (s *TabletServer) MutateRows(table string, ms []*tablet.RowMutation, opts tablet.MutateOptions) []error {
+	errs := make([]error, len(ms))
+
+	// 1. Group by tablet, remembering where each mutation came from
+	groups := make(map[*tablet.Tablet][]int)
+	var order []*tablet.Tablet
+	for i, m := range ms {
+		t := s.findTablet(table, m.RowKey)
+		if t == nil {
+			errs[i] = fmt.Errorf("%w: no tablet for key '%s'", errNotServed, m.RowKey)
+			continue
+		}
+		if _, ok := groups[t]; !ok {
+			order = append(order, t)
+		}
+		groups[t] = append(groups[t], i)
+	}
+
+	// 2. Apply every group in parallel, so their syncs overlap
+	var wg sync.WaitGroup
+	for _, t := range order {
+		idx := groups[t]
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			batch := make([]*tablet.RowMutation, len(idx))
+			for j, i := range idx {
+				batch[j] = ms[i]
+			}
+			for j, err := range t.MutateRows(batch, opts) {
+				errs[idx[j]] = err
+			}
+		}()
+	}
+	wg.Wait()
+	return errs
+}
+
+// HandleMutateRows applies many row mutations in one request. It takes
+// {"Entries": [{"RowKey": ..., "Ops": [...]}], "Durability": n} and responds with
+// one status per entry, in order, so that clients can retry only what failed.
+// Code is 200 on success and otherwise that of errorStatus.
+func (s *TabletServer) HandleMutateRows(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req struct {
+		Entries []struct {
+			RowKey string
+			Ops    []mutationOp
+		}
+		// Durability uses the tablet.Durability values; it defaults to sync.
+		Durability int
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	durability, err := toDurability(req.Durability)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Malformed entries fail on their own; the others are still applied.
+	errs := make([]error, len(req.Entries))
+	var ms []*tablet.RowMutation
+	var idx []int
+	for i, e := range req.Entries {
+		m, err := toRowMutation(e.RowKey, e.Ops)
+		if err != nil {
+			errs[i] = err
+			continue
+		}
+		ms = append(ms, m)
+		idx = append(idx, i)
+	}
+	for j, err := range s.MutateRows(tableParam(r), ms, tablet.MutateOptions{Durability: durability}) {
+		errs[idx[j]] = err
+	}
+
+	type entryStatus struct {
+		Code  int
+		Error string `json:",omitempty"`
+	}
+	resp := struct{ Statuses []entryStatus }{Statuses: make([]entryStatus, len(errs))}
+	for i, err := range errs {
+		if err == nil {
+			resp.Statuses[i] = entryStatus{Code: http.StatusOK}
 		} else {
-			rm.AddDelete(op.Family, op.Qualifier)
+			resp.Statuses[i] = entryStatus{Code: errorStatus(err), Error: err.Error()}
 		}
 	}
 
-	// Find Tablet
-	t := s.findTablet(rm.RowKey)
+	json.NewEncoder(w).Encode(resp)
+}
+
+// mutationOp is a mutation operation on the wire.
+type mutationOp struct {
+	Type      int // A tablet.MutationType value
+	Family    string
+	Qualifier string
+	// Timestamp is in nanoseconds. Over gRPC it is seen in microseconds, so
+	// only whole microseconds can be addressed exactly from there.
+	Timestamp    int64
+	Value        []byte
+	EndTimestamp int64
+}
+
+// toDurability converts a wire durability. Values tablet.Durability does not
+// define are rejected: the tablet would log the mutation but never sync it,
+// acknowledging it with less durability than the caller asked for.
+func toDurability(d int) (tablet.Durability, error) {
+	if d < int(tablet.DurabilitySync) || d > int(tablet.DurabilityNone) {
+		return 0, fmt.Errorf("invalid durability %d", d)
+	}
+	return tablet.Durability(d), nil
+}
+
+// toRowMutation converts wire operations to the internal mutation.
+// Operations of unknown types and malformed time ranges are rejected here,
+// so that the client gets a 400 rather than an internal error.
+func toRowMutation(rowKey string, ops []mutationOp) (*tablet.RowMutation, error) {
+	rm := tablet.NewRowMutation(rowKey)
+	for _, op := range ops {
+		rm.Ops = append(rm.Ops, tablet.MutationOperation{
+			Type:         tablet.MutationType(op.Type),
+			Family:       op.Family,
+			Qualifier:    op.Qualifier,
+			Timestamp:    op.Timestamp,
+			Value:        op.Value,
+			EndTimestamp: op.EndTimestamp,
+		})
+	}
+	if err := rm.Validate(); err != nil {
+		return nil, err
+	}
+	return rm, nil
+}
+
+// StatusNotServed answers requests for keys the server does not serve, for
+// example because their tablet moved or split. Clients take it to mean that
+// their tablet location is stale, whatever the error text says.
+const StatusNotServed = http.StatusMisdirectedRequest
+
+// errNotServed is the error of a batch entry for a key the server does not serve.
+var errNotServed = errors.New("key not served")
+
+// notServed responds that the server does not serve key.
+func notServed(w http.ResponseWriter, key string) {
+	http.Error(w, fmt.Sprintf("No tablet for key '%s'", key), StatusNotServed)
+}
+
+// errorStatus returns the status of a response to a failed tablet operation:
+// 400 for mutations that will never succeed as sent, StatusNotServed when the
+// tablet no longer serves the key (it was unloaded or split meanwhile), and
+// 500 for failures worth retrying.
+func errorStatus(err error) int {
+	switch {
+	case isInvalidMutation(err):
+		return http.StatusBadRequest
+	case errors.Is(err, errNotServed), errors.Is(err, tablet.ErrKeyOutOfRange), errors.Is(err, tablet.ErrTabletClosed):
+		return StatusNotServed
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// isInvalidMutation reports whether a mutation failed because of how it was
+// sent, so that retrying it can never succeed.
+func isInvalidMutation(err error) bool {
+	return errors.Is(err, tablet.ErrInvalidMutation) || errors.Is(err, tablet.ErrUnknownColumnFamily)
+}
+
+// HandleCheckAndMutate applies TrueOps to a row if Predicate (a tablet.RowFilter)
+// outputs any of its cells, and FalseOps otherwise, atomically.
+// It responds with {"Matched": bool}.
+func (s *TabletServer) HandleCheckAndMutate(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req struct {
+		RowKey    string
+		Predicate *tablet.RowFilter
+		TrueOps   []mutationOp
+		FalseOps  []mutationOp
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err := req.Predicate.Validate(); err != nil {
+		http.Error(w, fmt.Sprintf("invalid predicate: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	trueMutation, err := toRowMutation(req.RowKey, req.TrueOps)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("invalid TrueOps: %v", err), http.StatusBadRequest)
+		return
+	}
+	falseMutation, err := toRowMutation(req.RowKey, req.FalseOps)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("invalid FalseOps: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	t := s.findTablet(tableParam(r), req.RowKey)
 	if t == nil {
-		http.Error(w, "No tablet found for key", http.StatusInternalServerError)
+		notServed(w, req.RowKey)
 		return
 	}
 
-	if err := t.Mutate(rm); err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+	matched, err := t.CheckAndMutate(req.RowKey, req.Predicate, trueMutation, falseMutation)
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(struct{ Matched bool }{matched})
+}
+
+// HandleReadModifyWrite atomically increments or appends to cells of a row.
+// It takes a tablet.ReadModifyWriteRow and responds with the new cells as a tablet.Row.
+func (s *TabletServer) HandleReadModifyWrite(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var rmw tablet.ReadModifyWriteRow
+	if err := json.NewDecoder(r.Body).Decode(&rmw); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	t := s.findTablet(tableParam(r), rmw.RowKey)
+	if t == nil {
+		notServed(w, rmw.RowKey)
+		return
+	}
+
+	row, err := t.ReadModifyWrite(&rmw)
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(row)
+}
+
+// HandleColumnFamily declares (POST) or drops (DELETE) a column family on
+// every tablet of a standalone server's table.
+// POST takes a tablet.ColumnFamily body; DELETE takes the family in the "name" query parameter.
+// With a master, families are managed by the master's ModifyColumnFamilies.
+func (s *TabletServer) HandleColumnFamily(w http.ResponseWriter, r *http.Request) {
+	if s.heartbeatStop != nil {
+		http.Error(w, "column families are managed by the master", http.StatusConflict)
+		return
+	}
+	tablets := s.tabletsInRange(tableParam(r), "", "")
+
+	switch r.Method {
+	case http.MethodPost:
+		var family tablet.ColumnFamily
+		if err := json.NewDecoder(r.Body).Decode(&family); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		for _, t := range tablets {
+			if err := t.SetColumnFamily(family); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+		}
+	case http.MethodDelete:
+		name := r.URL.Query().Get("name")
+		for _, t := range tablets {
+			if err := t.DeleteColumnFamily(name); err != nil {
+				http.Error(w, err.Error(), http.StatusNotFound)
+				return
+			}
+		}
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
 		return
 	}
 
@@ -126,15 +467,15 @@ func (s *TabletServer) HandleRead(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	t := s.findTablet(key)
+	t := s.findTablet(tableParam(r), key)
 	if t == nil {
-		http.Error(w, "No tablet for key", http.StatusNotFound)
+		notServed(w, key)
 		return
 	}
 
 	ver, err := t.Read(key, family, qualifier)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		http.Error(w, err.Error(), errorStatus(err))
 		return
 	}
 	if ver == nil {
@@ -145,12 +486,148 @@ func (s *TabletServer) HandleRead(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(ver)
 }
 
-func (s *TabletServer) findTablet(key string) *tablet.Tablet {
+// HandleReadVersions returns the versions of a cell, newest first.
+// Besides key, family and qualifier it takes the optional version filters
+// described at parseReadOptions.
+func (s *TabletServer) HandleReadVersions(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	key := q.Get("key")
+	family := q.Get("family")
+	qualifier := q.Get("qualifier")
+
+	if key == "" || family == "" || qualifier == "" {
+		http.Error(w, "Missing params", http.StatusBadRequest)
+		return
+	}
+
+	opts, err := parseReadOptions(q)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	t := s.findTablet(tableParam(r), key)
+	if t == nil {
+		notServed(w, key)
+		return
+	}
+
+	versions, err := t.ReadVersions(key, family, qualifier, opts)
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+	if versions == nil {
+		versions = []tablet.CellVersion{}
+	}
+
+	json.NewEncoder(w).Encode(versions)
+}
+
+// HandleReadRow returns the cells of a row as a tablet.Row.
+// Besides key it takes the repeatable query parameters family and column
+// ("family:qualifier") to narrow the row, the version parameters of /versions,
+// and filter, a JSON-encoded tablet.RowFilter.
+func (s *TabletServer) HandleReadRow(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	key := q.Get("key")
+	if key == "" {
+		http.Error(w, "Missing params", http.StatusBadRequest)
+		return
+	}
+
+	versions, err := parseReadOptions(q)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	rowFilter, err := parseRowFilter(q.Get("filter"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	filter := tablet.ReadFilter{
+		Families: q["family"],
+		Columns:  q["column"],
+		Versions: versions,
+		Filter:   rowFilter,
+	}
+
+	t := s.findTablet(tableParam(r), key)
+	if t == nil {
+		notServed(w, key)
+		return
+	}
+
+	row, err := t.ReadRow(key, filter)
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+	if row == nil {
+		http.Error(w, "Not found", http.StatusNotFound)
+		return
+	}
+
+	json.NewEncoder(w).Encode(row)
+}
+
+// parseReadOptions reads the version filters shared by the read endpoints:
+// start and end (timestamp range [start, end)), max_versions and as_of.
+func parseReadOptions(q url.Values) (tablet.ReadOptions, error) {
+	var opts tablet.ReadOptions
+	var maxVersions int64
+	for name, dst := range map[string]*int64{
+		"start":        &opts.TimeRange.Start,
+		"end":          &opts.TimeRange.End,
+		"max_versions": &maxVersions,
+		"as_of":        &opts.AsOf,
+	} {
+		v := q.Get(name)
+		if v == "" {
+			continue
+		}
+		n, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return opts, fmt.Errorf("invalid %s: %v", name, err)
+		}
+		*dst = n
+	}
+	opts.MaxVersions = int(maxVersions)
+	return opts, nil
+}
+
+// parseRowFilter decodes and validates a JSON-encoded row filter. Empty means none.
+func parseRowFilter(data string) (*tablet.RowFilter, error) {
+	if data == "" {
+		return nil, nil
+	}
+	var f tablet.RowFilter
+	if err := json.Unmarshal([]byte(data), &f); err != nil {
+		return nil, fmt.Errorf("invalid filter: %v", err)
+	}
+	if err := f.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid filter: %v", err)
+	}
+	return &f, nil
+}
+
+// tableParam returns the table named by the "table" query parameter of a
+// request, or the default table.
+func tableParam(r *http.Request) string {
+	if table := r.URL.Query().Get("table"); table != "" {
+		return table
+	}
+	return master.DefaultTable
+}
+
+// findTablet returns the tablet of table serving key, or nil.
+func (s *TabletServer) findTablet(table, key string) *tablet.Tablet {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
 	for _, t := range s.Tablets {
-		if t.InRange(key) {
+		if s.tables[t.ID] == table && t.InRange(key) {
 			return t
 		}
 	}
@@ -0,0 +1,414 @@
+package tabletserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/Gourab-18/google_big_table/pkg/tablet"
+)
+
+// testOptions runs a standalone server without background compactions.
+func testOptions() Options {
+	opts := DefaultOptions()
+	opts.Tablet.CompactionStrategy = nil
+	return opts
+}
+
+func TestStandaloneServerLoadsTabletsFromManifests(t *testing.T) {
+	root := t.TempDir()
+	for _, r := range [][2]string{{"", "m"}, {"m", ""}} {
+		tb, err := tablet.NewTabletWithOptions(r[0], r[1], filepath.Join(root, "tablet_"+r[0]), testOptions().Tablet)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := tb.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	s, err := NewTabletServerWithOptions(root, testOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	var ranges []string
+	for _, tb := range s.Tablets {
+		ranges = append(ranges, "["+tb.StartKey+", "+tb.EndKey+")")
+	}
+	sort.Strings(ranges)
+	if len(ranges) != 2 || ranges[0] != "[, m)" || ranges[1] != "[m, )" {
+		t.Errorf("loaded %v, want [, m) and [m, )", ranges)
+	}
+}
+
+func TestStandaloneServerBootstrapsRootTablet(t *testing.T) {
+	s, err := NewTabletServerWithOptions(t.TempDir(), testOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	if len(s.Tablets) != 1 || s.Tablets[0].StartKey != "" || s.Tablets[0].EndKey != "" {
+		t.Fatalf("bootstrapped %d tablets, want one root tablet", len(s.Tablets))
+	}
+}
+
+// newTestServer starts a standalone server with a root tablet that has the "cf" family.
+func newTestServer(t *testing.T) *TabletServer {
+	t.Helper()
+	s, err := NewTabletServerWithOptions(t.TempDir(), testOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { s.Close() })
+	if err := s.Tablets[0].SetColumnFamily(tablet.ColumnFamily{Name: "cf"}); err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+// newTestServerWithTablets starts a standalone server serving a tablet with
+// the "cf" family for every key range.
+func newTestServerWithTablets(t *testing.T, ranges ...[2]string) *TabletServer {
+	t.Helper()
+	root := t.TempDir()
+	for i, r := range ranges {
+		tb, err := tablet.NewTabletWithOptions(r[0], r[1], filepath.Join(root, "tablet"+string(rune('a'+i))), testOptions().Tablet)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = tb.SetColumnFamily(tablet.ColumnFamily{Name: "cf"})
+		if cerr := tb.Close(); err == nil {
+			err = cerr
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	s, err := NewTabletServerWithOptions(root, testOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { s.Close() })
+	return s
+}
+
+// post sends body to handler and returns the recorded response.
+func post(handler http.HandlerFunc, path, body string) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	handler(rec, httptest.NewRequest(http.MethodPost, path, strings.NewReader(body)))
+	return rec
+}
+
+func TestMutateRejectsInvalidOps(t *testing.T) {
+	s := newTestServer(t)
+	tests := []struct {
+		name string
+		ops  string
+	}{
+		{"unknown type", `[{"Type": 9, "Family": "cf", "Qualifier": "q"}]`},
+		{"inverted range", `[{"Type": 2, "Family": "cf", "Qualifier": "q", "Timestamp": 5, "EndTimestamp": 3}]`},
+		{"unknown family", `[{"Type": 0, "Family": "nope", "Qualifier": "q", "Value": "dg=="}]`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body := `{"RowKey": "k", "Ops": [{"Type": 0, "Family": "cf", "Qualifier": "q", "Value": "dg=="}, ` + tt.ops[1:] + `}`
+			if rec := post(s.HandleMutate, "/mutate", body); rec.Code != http.StatusBadRequest {
+				t.Errorf("status = %d (%s), want 400", rec.Code, rec.Body)
+			}
+		})
+	}
+	if v, err := s.Tablets[0].Read("k", "cf", "q"); err != nil || v != nil {
+		t.Errorf("rejected mutations left %v behind (err %v)", v, err)
+	}
+}
+
+func TestMutateRowsRejectsInvalidEntriesAlone(t *testing.T) {
+	s := newTestServer(t)
+	body := `{"Entries": [
+		{"RowKey": "a", "Ops": [{"Type": 0, "Family": "cf", "Qualifier": "q", "Value": "dg=="}]},
+		{"RowKey": "b", "Ops": [{"Type": 9, "Family": "cf", "Qualifier": "q"}]},
+		{"RowKey": "c", "Ops": [{"Type": 0, "Family": "cf", "Qualifier": "q", "Value": "dg=="}]}
+	]}`
+	rec := post(s.HandleMutateRows, "/mutate-rows", body)
+	var resp struct{ Statuses []struct{ Code int } }
+	if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+		t.Fatal(err)
+	}
+	var codes []int
+	for _, st := range resp.Statuses {
+		codes = append(codes, st.Code)
+	}
+	if len(codes) != 3 || codes[0] != 200 || codes[1] != 400 || codes[2] != 200 {
+		t.Fatalf("statuses = %v, want [200 400 200]", codes)
+	}
+	for _, key := range []string{"a", "c"} {
+		if v, err := s.Tablets[0].Read(key, "cf", "q"); err != nil || v == nil {
+			t.Errorf("%s was not written (err %v)", key, err)
+		}
+	}
+}
+
+func TestCheckAndMutateRejectsInvalidOps(t *testing.T) {
+	s := newTestServer(t)
+	body := `{"RowKey": "k", "TrueOps": [{"Type": 0, "Family": "cf", "Qualifier": "q", "Value": "dg=="}], "FalseOps": [{"Type": 9}]}`
+	if rec := post(s.HandleCheckAndMutate, "/check-and-mutate", body); rec.Code != http.StatusBadRequest {
+		t.Errorf("status = %d (%s), want 400", rec.Code, rec.Body)
+	}
+}
+
+func TestMutateRejectsInvalidDurability(t *testing.T) {
+	s := newTestServer(t)
+	ops := `[{"Type": 0, "Family": "cf", "Qualifier": "q", "Value": "dg=="}]`
+	for _, durability := range []int{-1, 3, 5} {
+		body := fmt.Sprintf(`{"RowKey": "k", "Ops": %s, "Durability": %d}`, ops, durability)
+		if rec := post(s.HandleMutate, "/mutate", body); rec.Code != http.StatusBadRequest {
+			t.Errorf("mutate with durability %d = %d (%s), want 400", durability, rec.Code, rec.Body)
+		}
+		body = fmt.Sprintf(`{"Entries": [{"RowKey": "k", "Ops": %s}], "Durability": %d}`, ops, durability)
+		if rec := post(s.HandleMutateRows, "/mutate-rows", body); rec.Code != http.StatusBadRequest {
+			t.Errorf("mutate-rows with durability %d = %d (%s), want 400", durability, rec.Code, rec.Body)
+		}
+	}
+	if v, err := s.Tablets[0].Read("k", "cf", "q"); err != nil || v != nil {
+		t.Errorf("rejected mutations left %v behind (err %v)", v, err)
+	}
+
+	body := fmt.Sprintf(`{"RowKey": "k", "Ops": %s, "Durability": %d}`, ops, tablet.DurabilityNone)
+	if rec := post(s.HandleMutate, "/mutate", body); rec.Code != http.StatusOK {
+		t.Errorf("mutate with DurabilityNone = %d (%s), want 200", rec.Code, rec.Body)
+	}
+}
+
+func TestReadModifyWriteRejectsInvalidRules(t *testing.T) {
+	s := newTestServer(t)
+	if rec := post(s.HandleMutate, "/mutate", `{"RowKey": "k", "Ops": [{"Type": 0, "Family": "cf", "Qualifier": "s", "Value": "YWJj"}]}`); rec.Code != http.StatusOK {
+		t.Fatalf("mutate = %d (%s)", rec.Code, rec.Body)
+	}
+	tests := []struct {
+		name  string
+		rules string
+	}{
+		{"no rules", `[]`},
+		{"increment of a 3-byte value", `[{"Type": 0, "Family": "cf", "Qualifier": "s", "IncrementAmount": 1}]`},
+		{"unknown rule type", `[{"Type": 7, "Family": "cf", "Qualifier": "s"}]`},
+		{"unknown family", `[{"Type": 1, "Family": "nope", "Qualifier": "s", "AppendValue": "YQ=="}]`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := post(s.HandleReadModifyWrite, "/read-modify-write", `{"RowKey": "k", "Rules": `+tt.rules+`}`)
+			if rec.Code != http.StatusBadRequest {
+				t.Errorf("status = %d (%s), want 400", rec.Code, rec.Body)
+			}
+		})
+	}
+}
+
+// get sends a GET request for path to handler and returns the recorded response.
+func get(handler http.HandlerFunc, path string) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	handler(rec, httptest.NewRequest(http.MethodGet, path, nil))
+	return rec
+}
+
+func TestReadVersionsHandler(t *testing.T) {
+	s := newTestServer(t)
+	for _, ts := range []int64{10, 20, 30} {
+		m := tablet.NewRowMutation("k")
+		m.AddSet("cf", "q", ts, []byte(fmt.Sprint("v", ts)))
+		if err := s.Tablets[0].Mutate(m); err != nil {
+			t.Fatal(err)
+		}
+	}
+	tests := []struct {
+		query string
+		code  int
+		want  []int64
+	}{
+		{"key=k&family=cf&qualifier=q", http.StatusOK, []int64{30, 20, 10}},
+		{"key=k&family=cf&qualifier=q&max_versions=1", http.StatusOK, []int64{30}},
+		{"key=k&family=cf&qualifier=q&start=15&end=30", http.StatusOK, []int64{20}},
+		{"key=k&family=cf&qualifier=q&as_of=25&max_versions=1", http.StatusOK, []int64{20}},
+		{"key=k&family=cf&qualifier=missing", http.StatusOK, []int64{}},
+		{"key=k&family=cf&qualifier=q&as_of=soon", http.StatusBadRequest, nil},
+		{"key=k&family=cf", http.StatusBadRequest, nil},
+	}
+	for _, tt := range tests {
+		rec := get(s.HandleReadVersions, "/versions?"+tt.query)
+		if rec.Code != tt.code {
+			t.Errorf("/versions?%s = %d (%s), want %d", tt.query, rec.Code, rec.Body, tt.code)
+			continue
+		}
+		if tt.code != http.StatusOK {
+			continue
+		}
+		var versions []tablet.CellVersion
+		if err := json.NewDecoder(rec.Body).Decode(&versions); err != nil {
+			t.Fatal(err)
+		}
+		got := []int64{}
+		for _, v := range versions {
+			got = append(got, v.Timestamp)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("/versions?%s = %v, want %v", tt.query, got, tt.want)
+		}
+	}
+}
+
+func TestReadRowHandler(t *testing.T) {
+	s := newTestServer(t)
+	if err := s.Tablets[0].SetColumnFamily(tablet.ColumnFamily{Name: "cf2"}); err != nil {
+		t.Fatal(err)
+	}
+	m := tablet.NewRowMutation("r")
+	m.AddSet("cf", "a", 1, []byte("a1"))
+	m.AddSet("cf", "a", 2, []byte("a2"))
+	m.AddSet("cf2", "x", 1, []byte("x1"))
+	if err := s.Tablets[0].Mutate(m); err != nil {
+		t.Fatal(err)
+	}
+
+	filter := url.QueryEscape(`{"ValueRegex": "a."}`)
+	tests := []struct {
+		query string
+		code  int
+		want  map[string][]int64
+	}{
+		{"key=r", http.StatusOK, map[string][]int64{"cf:a": {2, 1}, "cf2:x": {1}}},
+		{"key=r&family=cf2", http.StatusOK, map[string][]int64{"cf2:x": {1}}},
+		{"key=r&column=cf:a&max_versions=1", http.StatusOK, map[string][]int64{"cf:a": {2}}},
+		{"key=r&family=cf2&column=cf:a&as_of=1", http.StatusOK, map[string][]int64{"cf:a": {1}, "cf2:x": {1}}},
+		{"key=r&filter=" + filter, http.StatusOK, map[string][]int64{"cf:a": {2, 1}}},
+		{"key=r&family=nope", http.StatusNotFound, nil},
+		{"key=missing", http.StatusNotFound, nil},
+		{"key=r&filter=" + url.QueryEscape(`{"ValueRegex": "("}`), http.StatusBadRequest, nil},
+		{"key=r&max_versions=many", http.StatusBadRequest, nil},
+		{"family=cf", http.StatusBadRequest, nil},
+	}
+	for _, tt := range tests {
+		rec := get(s.HandleReadRow, "/row?"+tt.query)
+		if rec.Code != tt.code {
+			t.Errorf("/row?%s = %d (%s), want %d", tt.query, rec.Code, rec.Body, tt.code)
+			continue
+		}
+		if tt.code != http.StatusOK {
+			continue
+		}
+		var row tablet.Row
+		if err := json.NewDecoder(rec.Body).Decode(&row); err != nil {
+			t.Fatal(err)
+		}
+		got := make(map[string][]int64)
+		for colKey, col := range row.Columns {
+			for _, v := range col.Versions {
+				got[colKey] = append(got[colKey], v.Timestamp)
+			}
+		}
+		if row.Key != "r" || !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("/row?%s = row %q with %v, want r with %v", tt.query, row.Key, got, tt.want)
+		}
+	}
+}
+
+func TestMutateRowsMixedBatch(t *testing.T) {
+	s := newTestServerWithTablets(t, [2]string{"", "m"}, [2]string{"m", "t"})
+	ok := `[{"Type": 0, "Family": "cf", "Qualifier": "q", "Value": "dg=="}]`
+	body := `{"Entries": [
+		{"RowKey": "a", "Ops": ` + ok + `},
+		{"RowKey": "b", "Ops": [{"Type": 0, "Family": "nope", "Qualifier": "q", "Value": "dg=="}]},
+		{"RowKey": "n", "Ops": ` + ok + `},
+		{"RowKey": "x", "Ops": ` + ok + `},
+		{"RowKey": "c", "Ops": [{"Type": 9, "Family": "cf", "Qualifier": "q"}]},
+		{"RowKey": "o", "Ops": ` + ok + `},
+		{"RowKey": "d", "Ops": ` + ok + `}
+	]}`
+	rec := post(s.HandleMutateRows, "/mutate-rows", body)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d (%s), want 200", rec.Code, rec.Body)
+	}
+	var resp struct {
+		Statuses []struct {
+			Code  int
+			Error string
+		}
+	}
+	if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+		t.Fatal(err)
+	}
+	var codes []int
+	for _, st := range resp.Statuses {
+		codes = append(codes, st.Code)
+		if (st.Code == http.StatusOK) != (st.Error == "") {
+			t.Errorf("status %d came with error %q", st.Code, st.Error)
+		}
+	}
+	// Entries keep their place whichever tablet, if any, they went to.
+	want := []int{200, 400, 200, StatusNotServed, 400, 200, 200}
+	if !reflect.DeepEqual(codes, want) {
+		t.Fatalf("statuses = %v, want %v", codes, want)
+	}
+	read := func(key string) *tablet.CellVersion {
+		for _, tb := range s.Tablets {
+			if tb.InRange(key) {
+				v, err := tb.Read(key, "cf", "q")
+				if err != nil {
+					t.Fatal(err)
+				}
+				return v
+			}
+		}
+		return nil
+	}
+	for _, key := range []string{"a", "d", "n", "o"} {
+		if v := read(key); v == nil || string(v.Value) != "v" {
+			t.Errorf("%s = %v, want it written", key, v)
+		}
+	}
+	for _, key := range []string{"b", "c"} {
+		if v := read(key); v != nil {
+			t.Errorf("%s = %v after a failed entry, want nothing", key, v)
+		}
+	}
+}
+
+// Requests for keys the server does not serve, or no longer serves, get
+// StatusNotServed from every endpoint so clients know to look the tablet up again.
+func TestUnservedKeysAreMisdirected(t *testing.T) {
+	s := newTestServerWithTablets(t, [2]string{"", "m"})
+	requests := func(key string) map[string]*httptest.ResponseRecorder {
+		set := fmt.Sprintf(`{"RowKey": %q, "Ops": [{"Type": 0, "Family": "cf", "Qualifier": "q", "Value": "dg=="}]}`, key)
+		return map[string]*httptest.ResponseRecorder{
+			"mutate":            post(s.HandleMutate, "/mutate", set),
+			"read":              get(s.HandleRead, "/read?key="+key+"&family=cf&qualifier=q"),
+			"versions":          get(s.HandleReadVersions, "/versions?key="+key+"&family=cf&qualifier=q"),
+			"row":               get(s.HandleReadRow, "/row?key="+key),
+			"scan":              get(s.HandleScan, "/scan?start="+key),
+			"check-and-mutate":  post(s.HandleCheckAndMutate, "/check-and-mutate", fmt.Sprintf(`{"RowKey": %q}`, key)),
+			"read-modify-write": post(s.HandleReadModifyWrite, "/read-modify-write", fmt.Sprintf(`{"RowKey": %q, "Rules": [{"Type": 0, "Family": "cf", "Qualifier": "n", "IncrementAmount": 1}]}`, key)),
+		}
+	}
+	for name, rec := range requests("z") {
+		if rec.Code != StatusNotServed {
+			t.Errorf("%s of an unserved key = %d (%s), want %d", name, rec.Code, rec.Body, StatusNotServed)
+		}
+	}
+
+	// A tablet closed for unloading no longer serves its keys either.
+	if err := s.Tablets[0].Close(); err != nil {
+		t.Fatal(err)
+	}
+	for name, rec := range requests("a") {
+		if rec.Code != StatusNotServed {
+			t.Errorf("%s of a key of a closed tablet = %d (%s), want %d", name, rec.Code, rec.Body, StatusNotServed)
+		}
+	}
+}